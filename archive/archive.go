@@ -0,0 +1,324 @@
+// Package archive reads and writes portable account archives. An archive is
+// a zip or tar file holding a manifest.json and a Markdown file with
+// front-matter for every page.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nathanborror/pages/pages"
+	"github.com/nathanborror/pages/wiki"
+)
+
+// ManifestVersion is the version of the archive layout written by Write.
+const ManifestVersion = 1
+
+// ErrUnknownFormat means the data is neither a zip nor a tar archive.
+var ErrUnknownFormat = errors.New("Unknown archive format")
+
+// ErrTooLarge means a file in an archive, or all of them together, are
+// larger than Read allows once decompressed.
+var ErrTooLarge = errors.New("Archive is too large")
+
+// Manifest describes the contents of an archive.
+type Manifest struct {
+	Version  int             `json:"version"`
+	Account  string          `json:"account"`
+	Exported string          `json:"exported"`
+	Pages    []ManifestEntry `json:"pages"`
+}
+
+// ManifestEntry locates a page within an archive.
+type ManifestEntry struct {
+	ID   string `json:"id"`
+	File string `json:"file"`
+}
+
+// Write writes an archive of the account's pages to w.
+func Write(w io.Writer, format pages.ArchiveFormat, account *pages.Account, recs []*pages.Page) error {
+	manifest := Manifest{
+		Version:  ManifestVersion,
+		Account:  account.Id,
+		Exported: formatTime(time.Now().UTC().UnixNano()),
+		Pages:    []ManifestEntry{},
+	}
+	recs = append([]*pages.Page(nil), recs...)
+	sort.Sort(byCreated(recs))
+	files := make(map[string][]byte)
+	var names []string
+	for _, rec := range recs {
+		name := path.Join("pages", rec.Id+".md")
+		manifest.Pages = append(manifest.Pages, ManifestEntry{ID: rec.Id, File: name})
+		files[name] = Markdown(rec)
+		names = append(names, name)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	files["manifest.json"] = append(data, '\n')
+	names = append([]string{"manifest.json"}, names...)
+
+	switch format {
+	case pages.ArchiveFormat_ZIP:
+		zw := zip.NewWriter(w)
+		for _, name := range names {
+			f, err := zw.Create(name)
+			if err != nil {
+				return err
+			}
+			if _, err := f.Write(files[name]); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	case pages.ArchiveFormat_TAR:
+		tw := tar.NewWriter(w)
+		for _, name := range names {
+			hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), ModTime: time.Now()}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := tw.Write(files[name]); err != nil {
+				return err
+			}
+		}
+		return tw.Close()
+	}
+	return ErrUnknownFormat
+}
+
+// Read returns the pages in a zip or tar archive. Every Markdown file is read
+// whether or not it is listed in the manifest, so directories of plain
+// Markdown files can be imported too; pages without an id in their
+// front-matter are returned with an empty ID.
+//
+// Markdown files larger than maxFile bytes, or totalling more than maxTotal,
+// fail with ErrTooLarge. A maxFile of zero doesn't limit files.
+func Read(data []byte, maxFile, maxTotal int64) ([]*pages.Page, error) {
+	files := make(map[string][]byte)
+	var names []string
+	var total int64
+	read := func(r io.Reader) ([]byte, error) {
+		max := maxTotal - total
+		if maxFile > 0 && maxFile < max {
+			max = maxFile
+		}
+		b, err := ioutil.ReadAll(io.LimitReader(r, max+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(b)) > max {
+			return nil, ErrTooLarge
+		}
+		total += int64(len(b))
+		return b, nil
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if !strings.HasSuffix(f.Name, ".md") {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			b, err := read(rc)
+			rc.Close()
+			if err != nil {
+				return nil, err
+			}
+			files[f.Name] = b
+			names = append(names, f.Name)
+		}
+	} else {
+		tr := tar.NewReader(bytes.NewReader(data))
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, ErrUnknownFormat
+			}
+			if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+				continue
+			}
+			if !strings.HasSuffix(hdr.Name, ".md") {
+				continue
+			}
+			b, err := read(tr)
+			if err != nil {
+				return nil, err
+			}
+			files[hdr.Name] = b
+			names = append(names, hdr.Name)
+		}
+	}
+
+	var out []*pages.Page
+	for _, name := range names {
+		rec, err := ParseMarkdown(files[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
+
+// Markdown returns a page as Markdown with front-matter.
+func Markdown(rec *pages.Page) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	fmt.Fprintf(&buf, "id: %s\n", rec.Id)
+	fmt.Fprintf(&buf, "created: %s\n", formatTime(rec.Created))
+	fmt.Fprintf(&buf, "modified: %s\n", formatTime(rec.Modified))
+	fmt.Fprintf(&buf, "visibility: %s\n", rec.Visibility)
+	fmt.Fprintf(&buf, "status: %s\n", rec.Status)
+	if rec.PublishAt != 0 {
+		fmt.Fprintf(&buf, "publish_at: %s\n", formatTime(rec.PublishAt))
+	}
+	if tags := wiki.Tags(rec.Text); len(tags) > 0 {
+		fmt.Fprintf(&buf, "tags: [%s]\n", strings.Join(tags, ", "))
+	}
+	buf.WriteString("---\n")
+	buf.WriteString(rec.Text)
+	return buf.Bytes()
+}
+
+// ParseMarkdown parses a page from Markdown with optional front-matter.
+// Tags are found in the text, so tags listed in the front-matter that the
+// text doesn't have are added to the end of it.
+func ParseMarkdown(data []byte) (*pages.Page, error) {
+	rec := &pages.Page{}
+	text := string(data)
+	if !strings.HasPrefix(text, "---\n") && !strings.HasPrefix(text, "---\r\n") {
+		rec.Text = text
+		return rec, nil
+	}
+	// Search from the newline ending the opening delimiter so empty
+	// front-matter is found too.
+	start := strings.Index(text, "\n")
+	end, size := -1, 0
+	for _, delim := range []string{"\n---\n", "\n---\r\n"} {
+		if i := strings.Index(text[start:], delim); i >= 0 && (end < 0 || i < end) {
+			end, size = i, len(delim)
+		}
+	}
+	if end < 0 {
+		return nil, errors.New("Unterminated front-matter")
+	}
+	header := text[start : start+end]
+	rec.Text = text[start+end+size:]
+
+	var tags []string
+	scanner := bufio.NewScanner(strings.NewReader(header))
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		key, value := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		var err error
+		switch key {
+		case "id":
+			rec.Id = value
+		case "created":
+			rec.Created, err = parseTime(value)
+		case "modified":
+			rec.Modified, err = parseTime(value)
+		case "visibility":
+			v, ok := pages.Visibility_value[strings.ToUpper(value)]
+			if !ok {
+				err = fmt.Errorf("Unknown visibility '%s'", value)
+			}
+			rec.Visibility = pages.Visibility(v)
+		case "status":
+			v, ok := pages.PageStatus_value[strings.ToUpper(value)]
+			if !ok {
+				err = fmt.Errorf("Unknown status '%s'", value)
+			}
+			rec.Status = pages.PageStatus(v)
+		case "publish_at":
+			rec.PublishAt, err = parseTime(value)
+		case "tags":
+			tags, err = parseTags(value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	have := make(map[string]bool)
+	for _, name := range wiki.Tags(rec.Text) {
+		have[name] = true
+	}
+	var missing []string
+	for _, name := range tags {
+		if !have[name] {
+			have[name] = true
+			missing = append(missing, "#"+name)
+		}
+	}
+	if len(missing) > 0 {
+		if rec.Text != "" && !strings.HasSuffix(rec.Text, "\n") {
+			rec.Text += "\n"
+		}
+		rec.Text += "\n" + strings.Join(missing, " ") + "\n"
+	}
+	return rec, nil
+}
+
+// parseTags parses a list of tags written as "[a, b]" or "a, b". Leading
+// #s are optional.
+func parseTags(value string) ([]string, error) {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	var out []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+		if name == "" {
+			continue
+		}
+		if tags := wiki.Tags("#" + name); len(tags) != 1 || len(tags[0]) != len(name) {
+			return nil, fmt.Errorf("Invalid tag '%s'", name)
+		}
+		out = append(out, strings.ToLower(name))
+	}
+	return out, nil
+}
+
+type byCreated []*pages.Page
+
+func (p byCreated) Len() int           { return len(p) }
+func (p byCreated) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byCreated) Less(i, j int) bool { return p[i].Created < p[j].Created }
+
+func formatTime(ts int64) string {
+	return time.Unix(0, ts).UTC().Format(time.RFC3339Nano)
+}
+
+func parseTime(value string) (int64, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
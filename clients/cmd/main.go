@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
+	"github.com/golang/protobuf/ptypes/field_mask"
 	"github.com/nathanborror/pages/pages"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -20,6 +22,7 @@ var (
 	identifier = flag.String("identifier", "", "Connect identifier")
 	text       = flag.String("text", "", "Page text")
 	id         = flag.String("id", "", "Page ID")
+	visibility = flag.String("visibility", "private", "Page visibility (private, unlisted or public)")
 )
 
 type client struct {
@@ -41,13 +44,18 @@ func (c *client) connect(ctx context.Context, identifier, password string) (*pag
 
 // Pages
 
-func (c *client) pageCreate(ctx context.Context, text string) (*pages.Page, error) {
-	newPage := &pages.PageCreateRequest{Text: text}
+func (c *client) pageCreate(ctx context.Context, text string, visibility pages.Visibility) (*pages.Page, error) {
+	newPage := &pages.PageCreateRequest{Text: text, Visibility: visibility}
 	return c.pages.PageCreate(ctx, newPage)
 }
 
-func (c *client) pageUpdate(ctx context.Context, id, text string) (*pages.Page, error) {
-	updatePage := &pages.PageUpdateRequest{Id: id, Text: text}
+func (c *client) pageUpdate(ctx context.Context, id, text string, visibility pages.Visibility) (*pages.Page, error) {
+	updatePage := &pages.PageUpdateRequest{
+		Id:         id,
+		Text:       text,
+		Visibility: visibility,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"text", "visibility"}},
+	}
 	return c.pages.PageUpdate(ctx, updatePage)
 }
 
@@ -57,7 +65,7 @@ func (c *client) pageGet(ctx context.Context, id string) (*pages.Page, error) {
 }
 
 func (c *client) pageList(ctx context.Context) (*pages.PagesSet, error) {
-	return c.pages.PageList(ctx, &pages.Empty{})
+	return c.pages.PageList(ctx, &pages.PageListRequest{})
 }
 
 func (c *client) pageDelete(ctx context.Context, id string) error {
@@ -68,6 +76,15 @@ func (c *client) pageDelete(ctx context.Context, id string) error {
 	return nil
 }
 
+func parseVisibility(s string) pages.Visibility {
+	v, ok := pages.Visibility_value[strings.ToUpper(s)]
+	if !ok {
+		fmt.Printf("page: unknown visibility '%s'\n", s)
+		os.Exit(1)
+	}
+	return pages.Visibility(v)
+}
+
 // Auth
 
 type auth struct {
@@ -90,7 +107,7 @@ func main() {
 	flag.Parse()
 
 	if len(os.Args) <= 1 {
-		fmt.Println(`Page is a command for writing pages.
+		fmt.Print(`Page is a command for writing pages.
 
 Usage:
 
@@ -177,7 +194,7 @@ The commands are:
 			fmt.Println(page.Text)
 		}
 	case "create":
-		page, err := c.pageCreate(ctx, *text)
+		page, err := c.pageCreate(ctx, *text, parseVisibility(*visibility))
 		if err != nil {
 			panic(err)
 		}
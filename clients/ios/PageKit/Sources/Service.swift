@@ -100,7 +100,7 @@ public class Service {
     }
 
     public func pageList(then: @escaping PagesHandler) {
-        let data = Empty()
+        let data = PageListRequest()
         call(route: .page(.list), data: data, token: nil, then: then)
     }
 
@@ -10,51 +10,6017 @@ import Foundation
 import SwiftProtobuf
 
 
+public enum ArchiveFormat: ProtobufEnum {
+  public typealias RawValue = Int
+  case zip // = 0
+  case tar // = 1
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .zip
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .zip
+    case 1: self = .tar
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "zip": self = .zip
+    case "tar": self = .tar
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "ZIP": self = .zip
+    case "TAR": self = .tar
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "ZIP": self = .zip
+    case "TAR": self = .tar
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .zip: return 0
+      case .tar: return 1
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .zip: return "\"ZIP\""
+      case .tar: return "\"TAR\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .zip: return ".zip"
+      case .tar: return ".tar"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum Visibility: ProtobufEnum {
+  public typealias RawValue = Int
+  case private_ // = 0
+  case unlisted // = 1
+  case public_ // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .private_
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .private_
+    case 1: self = .unlisted
+    case 2: self = .public_
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "private": self = .private_
+    case "unlisted": self = .unlisted
+    case "public": self = .public_
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "PRIVATE": self = .private_
+    case "UNLISTED": self = .unlisted
+    case "PUBLIC": self = .public_
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "PRIVATE": self = .private_
+    case "UNLISTED": self = .unlisted
+    case "PUBLIC": self = .public_
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .private_: return 0
+      case .unlisted: return 1
+      case .public_: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .private_: return "\"PRIVATE\""
+      case .unlisted: return "\"UNLISTED\""
+      case .public_: return "\"PUBLIC\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .private_: return ".private_"
+      case .unlisted: return ".unlisted"
+      case .public_: return ".public_"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum PageStatus: ProtobufEnum {
+  public typealias RawValue = Int
+  case published // = 0
+  case draft // = 1
+  case scheduled // = 2
+  case quarantined // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .published
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .published
+    case 1: self = .draft
+    case 2: self = .scheduled
+    case 3: self = .quarantined
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "published": self = .published
+    case "draft": self = .draft
+    case "scheduled": self = .scheduled
+    case "quarantined": self = .quarantined
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "PUBLISHED": self = .published
+    case "DRAFT": self = .draft
+    case "SCHEDULED": self = .scheduled
+    case "QUARANTINED": self = .quarantined
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "PUBLISHED": self = .published
+    case "DRAFT": self = .draft
+    case "SCHEDULED": self = .scheduled
+    case "QUARANTINED": self = .quarantined
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .published: return 0
+      case .draft: return 1
+      case .scheduled: return 2
+      case .quarantined: return 3
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .published: return "\"PUBLISHED\""
+      case .draft: return "\"DRAFT\""
+      case .scheduled: return "\"SCHEDULED\""
+      case .quarantined: return "\"QUARANTINED\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .published: return ".published"
+      case .draft: return ".draft"
+      case .scheduled: return ".scheduled"
+      case .quarantined: return ".quarantined"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum Role: ProtobufEnum {
+  public typealias RawValue = Int
+  case none // = 0
+  case viewer // = 1
+  case editor // = 2
+  case owner // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .none
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .none
+    case 1: self = .viewer
+    case 2: self = .editor
+    case 3: self = .owner
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "none": self = .none
+    case "viewer": self = .viewer
+    case "editor": self = .editor
+    case "owner": self = .owner
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "NONE": self = .none
+    case "VIEWER": self = .viewer
+    case "EDITOR": self = .editor
+    case "OWNER": self = .owner
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "NONE": self = .none
+    case "VIEWER": self = .viewer
+    case "EDITOR": self = .editor
+    case "OWNER": self = .owner
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .none: return 0
+      case .viewer: return 1
+      case .editor: return 2
+      case .owner: return 3
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .none: return "\"NONE\""
+      case .viewer: return "\"VIEWER\""
+      case .editor: return "\"EDITOR\""
+      case .owner: return "\"OWNER\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .none: return ".none"
+      case .viewer: return ".viewer"
+      case .editor: return ".editor"
+      case .owner: return ".owner"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum TextOpType: ProtobufEnum {
+  public typealias RawValue = Int
+  case insert // = 0
+  case delete // = 1
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .insert
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .insert
+    case 1: self = .delete
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "insert": self = .insert
+    case "delete": self = .delete
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "INSERT": self = .insert
+    case "DELETE": self = .delete
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "INSERT": self = .insert
+    case "DELETE": self = .delete
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .insert: return 0
+      case .delete: return 1
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .insert: return "\"INSERT\""
+      case .delete: return "\"DELETE\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .insert: return ".insert"
+      case .delete: return ".delete"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum BatchMode: ProtobufEnum {
+  public typealias RawValue = Int
+  case atomic // = 0
+  case bestEffort // = 1
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .atomic
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .atomic
+    case 1: self = .bestEffort
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "atomic": self = .atomic
+    case "bestEffort": self = .bestEffort
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "ATOMIC": self = .atomic
+    case "BEST_EFFORT": self = .bestEffort
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "ATOMIC": self = .atomic
+    case "BEST_EFFORT": self = .bestEffort
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .atomic: return 0
+      case .bestEffort: return 1
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .atomic: return "\"ATOMIC\""
+      case .bestEffort: return "\"BEST_EFFORT\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .atomic: return ".atomic"
+      case .bestEffort: return ".bestEffort"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum PageEventType: ProtobufEnum {
+  public typealias RawValue = Int
+  case created // = 0
+  case updated // = 1
+  case deleted // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .created
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .created
+    case 1: self = .updated
+    case 2: self = .deleted
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "created": self = .created
+    case "updated": self = .updated
+    case "deleted": self = .deleted
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "CREATED": self = .created
+    case "UPDATED": self = .updated
+    case "DELETED": self = .deleted
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "CREATED": self = .created
+    case "UPDATED": self = .updated
+    case "DELETED": self = .deleted
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .created: return 0
+      case .updated: return 1
+      case .deleted: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .created: return "\"CREATED\""
+      case .updated: return "\"UPDATED\""
+      case .deleted: return "\"DELETED\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .created: return ".created"
+      case .updated: return ".updated"
+      case .deleted: return ".deleted"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum SyncOutcome: ProtobufEnum {
+  public typealias RawValue = Int
+  case applied // = 0
+  case merged // = 1
+  case copied // = 2
+  case rejected // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .applied
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .applied
+    case 1: self = .merged
+    case 2: self = .copied
+    case 3: self = .rejected
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "applied": self = .applied
+    case "merged": self = .merged
+    case "copied": self = .copied
+    case "rejected": self = .rejected
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "APPLIED": self = .applied
+    case "MERGED": self = .merged
+    case "COPIED": self = .copied
+    case "REJECTED": self = .rejected
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "APPLIED": self = .applied
+    case "MERGED": self = .merged
+    case "COPIED": self = .copied
+    case "REJECTED": self = .rejected
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .applied: return 0
+      case .merged: return 1
+      case .copied: return 2
+      case .rejected: return 3
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .applied: return "\"APPLIED\""
+      case .merged: return "\"MERGED\""
+      case .copied: return "\"COPIED\""
+      case .rejected: return "\"REJECTED\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .applied: return ".applied"
+      case .merged: return ".merged"
+      case .copied: return ".copied"
+      case .rejected: return ".rejected"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum ModerationAction: ProtobufEnum {
+  public typealias RawValue = Int
+  case allow // = 0
+  case quarantine // = 1
+  case reject // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .allow
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .allow
+    case 1: self = .quarantine
+    case 2: self = .reject
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "allow": self = .allow
+    case "quarantine": self = .quarantine
+    case "reject": self = .reject
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "ALLOW": self = .allow
+    case "QUARANTINE": self = .quarantine
+    case "REJECT": self = .reject
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "ALLOW": self = .allow
+    case "QUARANTINE": self = .quarantine
+    case "REJECT": self = .reject
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .allow: return 0
+      case .quarantine: return 1
+      case .reject: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .allow: return "\"ALLOW\""
+      case .quarantine: return "\"QUARANTINE\""
+      case .reject: return "\"REJECT\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .allow: return ".allow"
+      case .quarantine: return ".quarantine"
+      case .reject: return ".reject"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum ReviewVerdict: ProtobufEnum {
+  public typealias RawValue = Int
+  case pending // = 0
+  case spam // = 1
+  case notSpam // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .pending
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .pending
+    case 1: self = .spam
+    case 2: self = .notSpam
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "pending": self = .pending
+    case "spam": self = .spam
+    case "notSpam": self = .notSpam
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "PENDING": self = .pending
+    case "SPAM": self = .spam
+    case "NOT_SPAM": self = .notSpam
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "PENDING": self = .pending
+    case "SPAM": self = .spam
+    case "NOT_SPAM": self = .notSpam
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .pending: return 0
+      case .spam: return 1
+      case .notSpam: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .pending: return "\"PENDING\""
+      case .spam: return "\"SPAM\""
+      case .notSpam: return "\"NOT_SPAM\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .pending: return ".pending"
+      case .spam: return ".spam"
+      case .notSpam: return ".notSpam"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
+public enum DeliveryStatus: ProtobufEnum {
+  public typealias RawValue = Int
+  case queued // = 0
+  case delivered // = 1
+  case failed // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .queued
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .queued
+    case 1: self = .delivered
+    case 2: self = .failed
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public init?(name: String) {
+    switch name {
+    case "queued": self = .queued
+    case "delivered": self = .delivered
+    case "failed": self = .failed
+    default: return nil
+    }
+  }
+
+  public init?(jsonName: String) {
+    switch jsonName {
+    case "QUEUED": self = .queued
+    case "DELIVERED": self = .delivered
+    case "FAILED": self = .failed
+    default: return nil
+    }
+  }
+
+  public init?(protoName: String) {
+    switch protoName {
+    case "QUEUED": self = .queued
+    case "DELIVERED": self = .delivered
+    case "FAILED": self = .failed
+    default: return nil
+    }
+  }
+
+  public var rawValue: Int {
+    get {
+      switch self {
+      case .queued: return 0
+      case .delivered: return 1
+      case .failed: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+  }
+
+  public var json: String {
+    get {
+      switch self {
+      case .queued: return "\"QUEUED\""
+      case .delivered: return "\"DELIVERED\""
+      case .failed: return "\"FAILED\""
+      case .UNRECOGNIZED(let i): return String(i)
+      }
+    }
+  }
+
+  public var hashValue: Int { return rawValue }
+
+  public var debugDescription: String {
+    get {
+      switch self {
+      case .queued: return ".queued"
+      case .delivered: return ".delivered"
+      case .failed: return ".failed"
+      case .UNRECOGNIZED(let v): return ".UNRECOGNIZED(\(v))"
+      }
+    }
+  }
+
+}
+
 public struct Empty: ProtobufGeneratedMessage {
   public var swiftClassName: String {return "Empty"}
   public var protoMessageName: String {return "Empty"}
   public var protoPackageName: String {return ""}
-  public var jsonFieldNames: [String: Int] {return [:]}
-  public var protoFieldNames: [String: Int] {return [:]}
+  public var jsonFieldNames: [String: Int] {return [:]}
+  public var protoFieldNames: [String: Int] {return [:]}
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return false // Proto3 ignores unknown fields
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+  }
+
+  public func _protoc_generated_isEqualTo(other: Empty) -> Bool {
+    return true
+  }
+}
+
+public struct Account: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Account"}
+  public var protoMessageName: String {return "Account"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "name": 2,
+    "email": 3,
+    "created": 5,
+    "modified": 6,
+    "plan": 7,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "name": 2,
+    "email": 3,
+    "created": 5,
+    "modified": 6,
+    "plan": 7,
+  ]}
+
+  public var id: String = ""
+
+  public var name: String = ""
+
+  public var email: String = ""
+
+  public var created: Int64 = 0
+
+  public var modified: Int64 = 0
+
+  public var plan: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
+    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &created)
+    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &modified)
+    case 7: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &plan)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 2, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
+    if email != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 3, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
+    }
+    if created != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: created, protoFieldNumber: 5, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+    }
+    if modified != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: modified, protoFieldNumber: 6, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
+    }
+    if plan != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: plan, protoFieldNumber: 7, protoFieldName: "plan", jsonFieldName: "plan", swiftFieldName: "plan")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: Account) -> Bool {
+    if id != other.id {return false}
+    if name != other.name {return false}
+    if email != other.email {return false}
+    if created != other.created {return false}
+    if modified != other.modified {return false}
+    if plan != other.plan {return false}
+    return true
+  }
+}
+
+public struct Session: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Session"}
+  public var protoMessageName: String {return "Session"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "account": 1,
+    "token": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "account": 1,
+    "token": 2,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Session
+    var _account: Account? = nil
+    var _token: String = ""
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_token)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 1, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _token != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _token, protoFieldNumber: 2, protoFieldName: "token", jsonFieldName: "token", swiftFieldName: "token")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _account != other._account {return false}
+      if _token != other._token {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._account = _account
+      clone._token = _token
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var token: String {
+    get {return _storage._token}
+    set {_uniqueStorage()._token = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Session) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct RegisterRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "RegisterRequest"}
+  public var protoMessageName: String {return "RegisterRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "name": 1,
+    "email": 2,
+    "password": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "name": 1,
+    "email": 2,
+    "password": 3,
+  ]}
+
+  public var name: String = ""
+
+  public var email: String = ""
+
+  public var password: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &password)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 1, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
+    if email != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 2, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
+    }
+    if password != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: password, protoFieldNumber: 3, protoFieldName: "password", jsonFieldName: "password", swiftFieldName: "password")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: RegisterRequest) -> Bool {
+    if name != other.name {return false}
+    if email != other.email {return false}
+    if password != other.password {return false}
+    return true
+  }
+}
+
+public struct ConnectRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ConnectRequest"}
+  public var protoMessageName: String {return "ConnectRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "identifier": 1,
+    "password": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "identifier": 1,
+    "password": 2,
+  ]}
+
+  public var identifier: String = ""
+
+  public var password: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &identifier)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &password)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if identifier != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: identifier, protoFieldNumber: 1, protoFieldName: "identifier", jsonFieldName: "identifier", swiftFieldName: "identifier")
+    }
+    if password != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: password, protoFieldNumber: 2, protoFieldName: "password", jsonFieldName: "password", swiftFieldName: "password")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ConnectRequest) -> Bool {
+    if identifier != other.identifier {return false}
+    if password != other.password {return false}
+    return true
+  }
+}
+
+public struct AccountExportRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "AccountExportRequest"}
+  public var protoMessageName: String {return "AccountExportRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "format": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "format": 1,
+  ]}
+
+  public var format: ArchiveFormat = ArchiveFormat.zip
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ArchiveFormat.self, value: &format)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if format != ArchiveFormat.zip {
+      try visitor.visitSingularField(fieldType: ArchiveFormat.self, value: format, protoFieldNumber: 1, protoFieldName: "format", jsonFieldName: "format", swiftFieldName: "format")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: AccountExportRequest) -> Bool {
+    if format != other.format {return false}
+    return true
+  }
+}
+
+public struct ArchiveChunk: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ArchiveChunk"}
+  public var protoMessageName: String {return "ArchiveChunk"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "data": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "data": 1,
+  ]}
+
+  public var data: Data = Data()
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufBytes.self, value: &data)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if data != Data() {
+      try visitor.visitSingularField(fieldType: ProtobufBytes.self, value: data, protoFieldNumber: 1, protoFieldName: "data", jsonFieldName: "data", swiftFieldName: "data")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ArchiveChunk) -> Bool {
+    if data != other.data {return false}
+    return true
+  }
+}
+
+public struct AccountImportResult: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "AccountImportResult"}
+  public var protoMessageName: String {return "AccountImportResult"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "created": 1,
+    "updated": 2,
+    "unchanged": 3,
+    "errors": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "created": 1,
+    "updated": 2,
+    "unchanged": 3,
+    "errors": 4,
+  ]}
+
+  public var created: Int64 = 0
+
+  public var updated: Int64 = 0
+
+  public var unchanged: Int64 = 0
+
+  public var errors: [String] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &created)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &updated)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &unchanged)
+    case 4: handled = try setter.decodeRepeatedField(fieldType: ProtobufString.self, value: &errors)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if created != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: created, protoFieldNumber: 1, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+    }
+    if updated != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: updated, protoFieldNumber: 2, protoFieldName: "updated", jsonFieldName: "updated", swiftFieldName: "updated")
+    }
+    if unchanged != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: unchanged, protoFieldNumber: 3, protoFieldName: "unchanged", jsonFieldName: "unchanged", swiftFieldName: "unchanged")
+    }
+    if !errors.isEmpty {
+      try visitor.visitRepeatedField(fieldType: ProtobufString.self, value: errors, protoFieldNumber: 4, protoFieldName: "errors", jsonFieldName: "errors", swiftFieldName: "errors")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: AccountImportResult) -> Bool {
+    if created != other.created {return false}
+    if updated != other.updated {return false}
+    if unchanged != other.unchanged {return false}
+    if errors != other.errors {return false}
+    return true
+  }
+}
+
+public struct Quota: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Quota"}
+  public var protoMessageName: String {return "Quota"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "plan": 1,
+    "pages": 2,
+    "maxPages": 3,
+    "storage": 4,
+    "maxStorage": 5,
+    "maxTextBytes": 6,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "plan": 1,
+    "pages": 2,
+    "max_pages": 3,
+    "storage": 4,
+    "max_storage": 5,
+    "max_text_bytes": 6,
+  ]}
+
+  public var plan: String = ""
+
+  public var pages: Int64 = 0
+
+  public var maxPages: Int64 = 0
+
+  public var storage: Int64 = 0
+
+  public var maxStorage: Int64 = 0
+
+  public var maxTextBytes: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &plan)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &pages)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &maxPages)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &storage)
+    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &maxStorage)
+    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &maxTextBytes)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if plan != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: plan, protoFieldNumber: 1, protoFieldName: "plan", jsonFieldName: "plan", swiftFieldName: "plan")
+    }
+    if pages != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: pages, protoFieldNumber: 2, protoFieldName: "pages", jsonFieldName: "pages", swiftFieldName: "pages")
+    }
+    if maxPages != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: maxPages, protoFieldNumber: 3, protoFieldName: "max_pages", jsonFieldName: "maxPages", swiftFieldName: "maxPages")
+    }
+    if storage != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: storage, protoFieldNumber: 4, protoFieldName: "storage", jsonFieldName: "storage", swiftFieldName: "storage")
+    }
+    if maxStorage != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: maxStorage, protoFieldNumber: 5, protoFieldName: "max_storage", jsonFieldName: "maxStorage", swiftFieldName: "maxStorage")
+    }
+    if maxTextBytes != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: maxTextBytes, protoFieldNumber: 6, protoFieldName: "max_text_bytes", jsonFieldName: "maxTextBytes", swiftFieldName: "maxTextBytes")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: Quota) -> Bool {
+    if plan != other.plan {return false}
+    if pages != other.pages {return false}
+    if maxPages != other.maxPages {return false}
+    if storage != other.storage {return false}
+    if maxStorage != other.maxStorage {return false}
+    if maxTextBytes != other.maxTextBytes {return false}
+    return true
+  }
+}
+
+public struct AccountPlanSetRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "AccountPlanSetRequest"}
+  public var protoMessageName: String {return "AccountPlanSetRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "accountId": 1,
+    "plan": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "account_id": 1,
+    "plan": 2,
+  ]}
+
+  public var accountId: String = ""
+
+  public var plan: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &accountId)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &plan)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if accountId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: accountId, protoFieldNumber: 1, protoFieldName: "account_id", jsonFieldName: "accountId", swiftFieldName: "accountId")
+    }
+    if plan != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: plan, protoFieldNumber: 2, protoFieldName: "plan", jsonFieldName: "plan", swiftFieldName: "plan")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: AccountPlanSetRequest) -> Bool {
+    if accountId != other.accountId {return false}
+    if plan != other.plan {return false}
+    return true
+  }
+}
+
+public struct PageGetRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageGetRequest"}
+  public var protoMessageName: String {return "PageGetRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "asOf": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "as_of": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var asOf: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &asOf)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if asOf != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: asOf, protoFieldNumber: 2, protoFieldName: "as_of", jsonFieldName: "asOf", swiftFieldName: "asOf")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageGetRequest) -> Bool {
+    if id != other.id {return false}
+    if asOf != other.asOf {return false}
+    return true
+  }
+}
+
+public struct PageListRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageListRequest"}
+  public var protoMessageName: String {return "PageListRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "asOf": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "as_of": 1,
+  ]}
+
+  public var asOf: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &asOf)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if asOf != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: asOf, protoFieldNumber: 1, protoFieldName: "as_of", jsonFieldName: "asOf", swiftFieldName: "asOf")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageListRequest) -> Bool {
+    if asOf != other.asOf {return false}
+    return true
+  }
+}
+
+public struct PageCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageCreateRequest"}
+  public var protoMessageName: String {return "PageCreateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "text": 1,
+    "visibility": 2,
+    "templateId": 3,
+    "variables": 4,
+    "status": 5,
+    "publishAt": 6,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "text": 1,
+    "visibility": 2,
+    "template_id": 3,
+    "variables": 4,
+    "status": 5,
+    "publish_at": 6,
+  ]}
+
+  public var text: String = ""
+
+  public var visibility: Visibility = Visibility.private_
+
+  public var templateId: String = ""
+
+  public var variables: Dictionary<String,String> = [:]
+
+  public var status: PageStatus = PageStatus.published
+
+  public var publishAt: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    case 2: handled = try setter.decodeSingularField(fieldType: Visibility.self, value: &visibility)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &templateId)
+    case 4: handled = try setter.decodeMapField(fieldType: ProtobufMap<ProtobufString,ProtobufString>.self, value: &variables)
+    case 5: handled = try setter.decodeSingularField(fieldType: PageStatus.self, value: &status)
+    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &publishAt)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if text != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 1, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    }
+    if visibility != Visibility.private_ {
+      try visitor.visitSingularField(fieldType: Visibility.self, value: visibility, protoFieldNumber: 2, protoFieldName: "visibility", jsonFieldName: "visibility", swiftFieldName: "visibility")
+    }
+    if templateId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: templateId, protoFieldNumber: 3, protoFieldName: "template_id", jsonFieldName: "templateId", swiftFieldName: "templateId")
+    }
+    if !variables.isEmpty {
+      try visitor.visitMapField(fieldType: ProtobufMap<ProtobufString,ProtobufString>.self, value: variables, protoFieldNumber: 4, protoFieldName: "variables", jsonFieldName: "variables", swiftFieldName: "variables")
+    }
+    if status != PageStatus.published {
+      try visitor.visitSingularField(fieldType: PageStatus.self, value: status, protoFieldNumber: 5, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+    }
+    if publishAt != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: publishAt, protoFieldNumber: 6, protoFieldName: "publish_at", jsonFieldName: "publishAt", swiftFieldName: "publishAt")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageCreateRequest) -> Bool {
+    if text != other.text {return false}
+    if visibility != other.visibility {return false}
+    if templateId != other.templateId {return false}
+    if variables != other.variables {return false}
+    if status != other.status {return false}
+    if publishAt != other.publishAt {return false}
+    return true
+  }
+}
+
+public struct PageUpdateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageUpdateRequest"}
+  public var protoMessageName: String {return "PageUpdateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "text": 2,
+    "visibility": 3,
+    "updateMask": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "text": 2,
+    "visibility": 3,
+    "update_mask": 4,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageUpdateRequest
+    var _id: String = ""
+    var _text: String = ""
+    var _visibility: Visibility = Visibility.private_
+    var _updateMask: Google_Protobuf_FieldMask? = nil
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 3: handled = try setter.decodeSingularField(fieldType: Visibility.self, value: &_visibility)
+      case 4: handled = try setter.decodeSingularMessageField(fieldType: Google_Protobuf_FieldMask.self, value: &_updateMask)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 2, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if _visibility != Visibility.private_ {
+        try visitor.visitSingularField(fieldType: Visibility.self, value: _visibility, protoFieldNumber: 3, protoFieldName: "visibility", jsonFieldName: "visibility", swiftFieldName: "visibility")
+      }
+      if let v = _updateMask {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 4, protoFieldName: "update_mask", jsonFieldName: "updateMask", swiftFieldName: "updateMask")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _text != other._text {return false}
+      if _visibility != other._visibility {return false}
+      if _updateMask != other._updateMask {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._text = _text
+      clone._visibility = _visibility
+      clone._updateMask = _updateMask
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var visibility: Visibility {
+    get {return _storage._visibility}
+    set {_uniqueStorage()._visibility = newValue}
+  }
+
+  public var updateMask: Google_Protobuf_FieldMask {
+    get {return _storage._updateMask ?? Google_Protobuf_FieldMask()}
+    set {_uniqueStorage()._updateMask = newValue}
+  }
+  public var hasUpdateMask: Bool {
+    return _storage._updateMask != nil
+  }
+  public mutating func clearUpdateMask() {
+    return _storage._updateMask = nil
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageUpdateRequest) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct TextOp: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "TextOp"}
+  public var protoMessageName: String {return "TextOp"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "type": 1,
+    "offset": 2,
+    "text": 3,
+    "length": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "type": 1,
+    "offset": 2,
+    "text": 3,
+    "length": 4,
+  ]}
+
+  public var type: TextOpType = TextOpType.insert
+
+  public var offset: Int64 = 0
+
+  public var text: String = ""
+
+  public var length: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: TextOpType.self, value: &type)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &offset)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &length)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if type != TextOpType.insert {
+      try visitor.visitSingularField(fieldType: TextOpType.self, value: type, protoFieldNumber: 1, protoFieldName: "type", jsonFieldName: "type", swiftFieldName: "type")
+    }
+    if offset != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: offset, protoFieldNumber: 2, protoFieldName: "offset", jsonFieldName: "offset", swiftFieldName: "offset")
+    }
+    if text != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 3, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    }
+    if length != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: length, protoFieldNumber: 4, protoFieldName: "length", jsonFieldName: "length", swiftFieldName: "length")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: TextOp) -> Bool {
+    if type != other.type {return false}
+    if offset != other.offset {return false}
+    if text != other.text {return false}
+    if length != other.length {return false}
+    return true
+  }
+}
+
+public struct PagePatchRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PagePatchRequest"}
+  public var protoMessageName: String {return "PagePatchRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "baseVersion": 2,
+    "ops": 3,
+    "diff": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "base_version": 2,
+    "ops": 3,
+    "diff": 4,
+  ]}
+
+  public var id: String = ""
+
+  public var baseVersion: Int64 = 0
+
+  public var ops: [TextOp] = []
+
+  public var diff: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &baseVersion)
+    case 3: handled = try setter.decodeRepeatedMessageField(fieldType: TextOp.self, value: &ops)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &diff)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if baseVersion != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: baseVersion, protoFieldNumber: 2, protoFieldName: "base_version", jsonFieldName: "baseVersion", swiftFieldName: "baseVersion")
+    }
+    if !ops.isEmpty {
+      try visitor.visitRepeatedMessageField(value: ops, protoFieldNumber: 3, protoFieldName: "ops", jsonFieldName: "ops", swiftFieldName: "ops")
+    }
+    if diff != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: diff, protoFieldNumber: 4, protoFieldName: "diff", jsonFieldName: "diff", swiftFieldName: "diff")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PagePatchRequest) -> Bool {
+    if id != other.id {return false}
+    if baseVersion != other.baseVersion {return false}
+    if ops != other.ops {return false}
+    if diff != other.diff {return false}
+    return true
+  }
+}
+
+public struct PageStatusUpdateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageStatusUpdateRequest"}
+  public var protoMessageName: String {return "PageStatusUpdateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "status": 2,
+    "publishAt": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "status": 2,
+    "publish_at": 3,
+  ]}
+
+  public var id: String = ""
+
+  public var status: PageStatus = PageStatus.published
+
+  public var publishAt: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: PageStatus.self, value: &status)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &publishAt)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if status != PageStatus.published {
+      try visitor.visitSingularField(fieldType: PageStatus.self, value: status, protoFieldNumber: 2, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+    }
+    if publishAt != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: publishAt, protoFieldNumber: 3, protoFieldName: "publish_at", jsonFieldName: "publishAt", swiftFieldName: "publishAt")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageStatusUpdateRequest) -> Bool {
+    if id != other.id {return false}
+    if status != other.status {return false}
+    if publishAt != other.publishAt {return false}
+    return true
+  }
+}
+
+public struct PageDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageDeleteRequest"}
+  public var protoMessageName: String {return "PageDeleteRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageDeleteRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct PageLock: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageLock"}
+  public var protoMessageName: String {return "PageLock"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pageId": 1,
+    "account": 2,
+    "acquired": 3,
+    "expires": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page_id": 1,
+    "account": 2,
+    "acquired": 3,
+    "expires": 4,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageLock
+    var _pageId: String = ""
+    var _account: Account? = nil
+    var _acquired: Int64 = 0
+    var _expires: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_pageId)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_acquired)
+      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_expires)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _pageId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _pageId, protoFieldNumber: 1, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _acquired != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _acquired, protoFieldNumber: 3, protoFieldName: "acquired", jsonFieldName: "acquired", swiftFieldName: "acquired")
+      }
+      if _expires != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _expires, protoFieldNumber: 4, protoFieldName: "expires", jsonFieldName: "expires", swiftFieldName: "expires")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _pageId != other._pageId {return false}
+      if _account != other._account {return false}
+      if _acquired != other._acquired {return false}
+      if _expires != other._expires {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._pageId = _pageId
+      clone._account = _account
+      clone._acquired = _acquired
+      clone._expires = _expires
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var pageId: String {
+    get {return _storage._pageId}
+    set {_uniqueStorage()._pageId = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var acquired: Int64 {
+    get {return _storage._acquired}
+    set {_uniqueStorage()._acquired = newValue}
+  }
+
+  public var expires: Int64 {
+    get {return _storage._expires}
+    set {_uniqueStorage()._expires = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageLock) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct PageLockRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageLockRequest"}
+  public var protoMessageName: String {return "PageLockRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "ttl": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "ttl": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var ttl: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &ttl)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if ttl != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: ttl, protoFieldNumber: 2, protoFieldName: "ttl", jsonFieldName: "ttl", swiftFieldName: "ttl")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageLockRequest) -> Bool {
+    if id != other.id {return false}
+    if ttl != other.ttl {return false}
+    return true
+  }
+}
+
+public struct PageBatchCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageBatchCreateRequest"}
+  public var protoMessageName: String {return "PageBatchCreateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pages": 1,
+    "mode": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "pages": 1,
+    "mode": 2,
+  ]}
+
+  public var pages: [PageCreateRequest] = []
+
+  public var mode: BatchMode = BatchMode.atomic
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageCreateRequest.self, value: &pages)
+    case 2: handled = try setter.decodeSingularField(fieldType: BatchMode.self, value: &mode)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !pages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: pages, protoFieldNumber: 1, protoFieldName: "pages", jsonFieldName: "pages", swiftFieldName: "pages")
+    }
+    if mode != BatchMode.atomic {
+      try visitor.visitSingularField(fieldType: BatchMode.self, value: mode, protoFieldNumber: 2, protoFieldName: "mode", jsonFieldName: "mode", swiftFieldName: "mode")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageBatchCreateRequest) -> Bool {
+    if pages != other.pages {return false}
+    if mode != other.mode {return false}
+    return true
+  }
+}
+
+public struct PageBatchUpdateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageBatchUpdateRequest"}
+  public var protoMessageName: String {return "PageBatchUpdateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pages": 1,
+    "mode": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "pages": 1,
+    "mode": 2,
+  ]}
+
+  public var pages: [PageUpdateRequest] = []
+
+  public var mode: BatchMode = BatchMode.atomic
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageUpdateRequest.self, value: &pages)
+    case 2: handled = try setter.decodeSingularField(fieldType: BatchMode.self, value: &mode)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !pages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: pages, protoFieldNumber: 1, protoFieldName: "pages", jsonFieldName: "pages", swiftFieldName: "pages")
+    }
+    if mode != BatchMode.atomic {
+      try visitor.visitSingularField(fieldType: BatchMode.self, value: mode, protoFieldNumber: 2, protoFieldName: "mode", jsonFieldName: "mode", swiftFieldName: "mode")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageBatchUpdateRequest) -> Bool {
+    if pages != other.pages {return false}
+    if mode != other.mode {return false}
+    return true
+  }
+}
+
+public struct PageBatchDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageBatchDeleteRequest"}
+  public var protoMessageName: String {return "PageBatchDeleteRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "ids": 1,
+    "mode": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "ids": 1,
+    "mode": 2,
+  ]}
+
+  public var ids: [String] = []
+
+  public var mode: BatchMode = BatchMode.atomic
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedField(fieldType: ProtobufString.self, value: &ids)
+    case 2: handled = try setter.decodeSingularField(fieldType: BatchMode.self, value: &mode)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !ids.isEmpty {
+      try visitor.visitRepeatedField(fieldType: ProtobufString.self, value: ids, protoFieldNumber: 1, protoFieldName: "ids", jsonFieldName: "ids", swiftFieldName: "ids")
+    }
+    if mode != BatchMode.atomic {
+      try visitor.visitSingularField(fieldType: BatchMode.self, value: mode, protoFieldNumber: 2, protoFieldName: "mode", jsonFieldName: "mode", swiftFieldName: "mode")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageBatchDeleteRequest) -> Bool {
+    if ids != other.ids {return false}
+    if mode != other.mode {return false}
+    return true
+  }
+}
+
+public struct PageBatchItem: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageBatchItem"}
+  public var protoMessageName: String {return "PageBatchItem"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "page": 1,
+    "code": 2,
+    "error": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page": 1,
+    "code": 2,
+    "error": 3,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageBatchItem
+    var _page: Page? = nil
+    var _code: Int32 = 0
+    var _error: String = ""
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt32.self, value: &_code)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_error)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 1, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+      if _code != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt32.self, value: _code, protoFieldNumber: 2, protoFieldName: "code", jsonFieldName: "code", swiftFieldName: "code")
+      }
+      if _error != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _error, protoFieldNumber: 3, protoFieldName: "error", jsonFieldName: "error", swiftFieldName: "error")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _page != other._page {return false}
+      if _code != other._code {return false}
+      if _error != other._error {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._page = _page
+      clone._code = _code
+      clone._error = _error
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public var code: Int32 {
+    get {return _storage._code}
+    set {_uniqueStorage()._code = newValue}
+  }
+
+  public var error: String {
+    get {return _storage._error}
+    set {_uniqueStorage()._error = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageBatchItem) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct PageBatchResult: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageBatchResult"}
+  public var protoMessageName: String {return "PageBatchResult"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "items": 1,
+    "committed": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "items": 1,
+    "committed": 2,
+  ]}
+
+  public var items: [PageBatchItem] = []
+
+  public var committed: Bool = false
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageBatchItem.self, value: &items)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &committed)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !items.isEmpty {
+      try visitor.visitRepeatedMessageField(value: items, protoFieldNumber: 1, protoFieldName: "items", jsonFieldName: "items", swiftFieldName: "items")
+    }
+    if committed != false {
+      try visitor.visitSingularField(fieldType: ProtobufBool.self, value: committed, protoFieldNumber: 2, protoFieldName: "committed", jsonFieldName: "committed", swiftFieldName: "committed")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageBatchResult) -> Bool {
+    if items != other.items {return false}
+    if committed != other.committed {return false}
+    return true
+  }
+}
+
+public struct Page: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Page"}
+  public var protoMessageName: String {return "Page"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "text": 3,
+    "created": 4,
+    "modified": 5,
+    "visibility": 6,
+    "version": 7,
+    "attachments": 8,
+    "title": 9,
+    "status": 10,
+    "publishAt": 11,
+    "lock": 12,
+    "forkedFrom": 13,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "text": 3,
+    "created": 4,
+    "modified": 5,
+    "visibility": 6,
+    "version": 7,
+    "attachments": 8,
+    "title": 9,
+    "status": 10,
+    "publish_at": 11,
+    "lock": 12,
+    "forked_from": 13,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Page
+    var _id: String = ""
+    var _account: Account? = nil
+    var _text: String = ""
+    var _created: Int64 = 0
+    var _modified: Int64 = 0
+    var _visibility: Visibility = Visibility.private_
+    var _version: Int64 = 0
+    var _attachments: [Attachment] = []
+    var _title: String = ""
+    var _status: PageStatus = PageStatus.published
+    var _publishAt: Int64 = 0
+    var _lock: PageLock? = nil
+    var _forkedFrom: String = ""
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_modified)
+      case 6: handled = try setter.decodeSingularField(fieldType: Visibility.self, value: &_visibility)
+      case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_version)
+      case 8: handled = try setter.decodeRepeatedMessageField(fieldType: Attachment.self, value: &_attachments)
+      case 9: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_title)
+      case 10: handled = try setter.decodeSingularField(fieldType: PageStatus.self, value: &_status)
+      case 11: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_publishAt)
+      case 12: handled = try setter.decodeSingularMessageField(fieldType: PageLock.self, value: &_lock)
+      case 13: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_forkedFrom)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 3, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 4, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+      if _modified != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _modified, protoFieldNumber: 5, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
+      }
+      if _visibility != Visibility.private_ {
+        try visitor.visitSingularField(fieldType: Visibility.self, value: _visibility, protoFieldNumber: 6, protoFieldName: "visibility", jsonFieldName: "visibility", swiftFieldName: "visibility")
+      }
+      if _version != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _version, protoFieldNumber: 7, protoFieldName: "version", jsonFieldName: "version", swiftFieldName: "version")
+      }
+      if !_attachments.isEmpty {
+        try visitor.visitRepeatedMessageField(value: _attachments, protoFieldNumber: 8, protoFieldName: "attachments", jsonFieldName: "attachments", swiftFieldName: "attachments")
+      }
+      if _title != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _title, protoFieldNumber: 9, protoFieldName: "title", jsonFieldName: "title", swiftFieldName: "title")
+      }
+      if _status != PageStatus.published {
+        try visitor.visitSingularField(fieldType: PageStatus.self, value: _status, protoFieldNumber: 10, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+      }
+      if _publishAt != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _publishAt, protoFieldNumber: 11, protoFieldName: "publish_at", jsonFieldName: "publishAt", swiftFieldName: "publishAt")
+      }
+      if let v = _lock {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 12, protoFieldName: "lock", jsonFieldName: "lock", swiftFieldName: "lock")
+      }
+      if _forkedFrom != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _forkedFrom, protoFieldNumber: 13, protoFieldName: "forked_from", jsonFieldName: "forkedFrom", swiftFieldName: "forkedFrom")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _account != other._account {return false}
+      if _text != other._text {return false}
+      if _created != other._created {return false}
+      if _modified != other._modified {return false}
+      if _visibility != other._visibility {return false}
+      if _version != other._version {return false}
+      if _attachments != other._attachments {return false}
+      if _title != other._title {return false}
+      if _status != other._status {return false}
+      if _publishAt != other._publishAt {return false}
+      if _lock != other._lock {return false}
+      if _forkedFrom != other._forkedFrom {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._account = _account
+      clone._text = _text
+      clone._created = _created
+      clone._modified = _modified
+      clone._visibility = _visibility
+      clone._version = _version
+      clone._attachments = _attachments
+      clone._title = _title
+      clone._status = _status
+      clone._publishAt = _publishAt
+      clone._lock = _lock
+      clone._forkedFrom = _forkedFrom
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public var modified: Int64 {
+    get {return _storage._modified}
+    set {_uniqueStorage()._modified = newValue}
+  }
+
+  public var visibility: Visibility {
+    get {return _storage._visibility}
+    set {_uniqueStorage()._visibility = newValue}
+  }
+
+  public var version: Int64 {
+    get {return _storage._version}
+    set {_uniqueStorage()._version = newValue}
+  }
+
+  public var attachments: [Attachment] {
+    get {return _storage._attachments}
+    set {_uniqueStorage()._attachments = newValue}
+  }
+
+  public var title: String {
+    get {return _storage._title}
+    set {_uniqueStorage()._title = newValue}
+  }
+
+  public var status: PageStatus {
+    get {return _storage._status}
+    set {_uniqueStorage()._status = newValue}
+  }
+
+  public var publishAt: Int64 {
+    get {return _storage._publishAt}
+    set {_uniqueStorage()._publishAt = newValue}
+  }
+
+  public var lock: PageLock {
+    get {return _storage._lock ?? PageLock()}
+    set {_uniqueStorage()._lock = newValue}
+  }
+  public var hasLock: Bool {
+    return _storage._lock != nil
+  }
+  public mutating func clearLock() {
+    return _storage._lock = nil
+  }
+
+  public var forkedFrom: String {
+    get {return _storage._forkedFrom}
+    set {_uniqueStorage()._forkedFrom = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Page) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct PagesSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PagesSet"}
+  public var protoMessageName: String {return "PagesSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pages": 1,
+    "total": 2,
+    "page": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "pages": 1,
+    "total": 2,
+    "page": 3,
+  ]}
+
+  public var pages: [Page] = []
+
+  public var total: Int64 = 0
+
+  public var page: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Page.self, value: &pages)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &total)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &page)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !pages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: pages, protoFieldNumber: 1, protoFieldName: "pages", jsonFieldName: "pages", swiftFieldName: "pages")
+    }
+    if total != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: total, protoFieldNumber: 2, protoFieldName: "total", jsonFieldName: "total", swiftFieldName: "total")
+    }
+    if page != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: page, protoFieldNumber: 3, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PagesSet) -> Bool {
+    if pages != other.pages {return false}
+    if total != other.total {return false}
+    if page != other.page {return false}
+    return true
+  }
+}
+
+public struct PageShareRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageShareRequest"}
+  public var protoMessageName: String {return "PageShareRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "email": 2,
+    "role": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "email": 2,
+    "role": 3,
+  ]}
+
+  public var id: String = ""
+
+  public var email: String = ""
+
+  public var role: Role = Role.none
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
+    case 3: handled = try setter.decodeSingularField(fieldType: Role.self, value: &role)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if email != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 2, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
+    }
+    if role != Role.none {
+      try visitor.visitSingularField(fieldType: Role.self, value: role, protoFieldNumber: 3, protoFieldName: "role", jsonFieldName: "role", swiftFieldName: "role")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageShareRequest) -> Bool {
+    if id != other.id {return false}
+    if email != other.email {return false}
+    if role != other.role {return false}
+    return true
+  }
+}
+
+public struct PageUnshareRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageUnshareRequest"}
+  public var protoMessageName: String {return "PageUnshareRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "email": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "email": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var email: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if email != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 2, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageUnshareRequest) -> Bool {
+    if id != other.id {return false}
+    if email != other.email {return false}
+    return true
+  }
+}
+
+public struct PageCollaboratorsRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageCollaboratorsRequest"}
+  public var protoMessageName: String {return "PageCollaboratorsRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageCollaboratorsRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct Collaborator: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Collaborator"}
+  public var protoMessageName: String {return "Collaborator"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "account": 1,
+    "role": 2,
+    "created": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "account": 1,
+    "role": 2,
+    "created": 3,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Collaborator
+    var _account: Account? = nil
+    var _role: Role = Role.none
+    var _created: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 2: handled = try setter.decodeSingularField(fieldType: Role.self, value: &_role)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 1, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _role != Role.none {
+        try visitor.visitSingularField(fieldType: Role.self, value: _role, protoFieldNumber: 2, protoFieldName: "role", jsonFieldName: "role", swiftFieldName: "role")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 3, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _account != other._account {return false}
+      if _role != other._role {return false}
+      if _created != other._created {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._account = _account
+      clone._role = _role
+      clone._created = _created
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var role: Role {
+    get {return _storage._role}
+    set {_uniqueStorage()._role = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Collaborator) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct CollaboratorsSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CollaboratorsSet"}
+  public var protoMessageName: String {return "CollaboratorsSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "collaborators": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "collaborators": 1,
+  ]}
+
+  public var collaborators: [Collaborator] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Collaborator.self, value: &collaborators)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !collaborators.isEmpty {
+      try visitor.visitRepeatedMessageField(value: collaborators, protoFieldNumber: 1, protoFieldName: "collaborators", jsonFieldName: "collaborators", swiftFieldName: "collaborators")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CollaboratorsSet) -> Bool {
+    if collaborators != other.collaborators {return false}
+    return true
+  }
+}
+
+public struct PageLinksRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageLinksRequest"}
+  public var protoMessageName: String {return "PageLinksRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageLinksRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct PageForkRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageForkRequest"}
+  public var protoMessageName: String {return "PageForkRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "status": 2,
+    "publishAt": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "status": 2,
+    "publish_at": 3,
+  ]}
+
+  public var id: String = ""
+
+  public var status: PageStatus = PageStatus.published
+
+  public var publishAt: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: PageStatus.self, value: &status)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &publishAt)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if status != PageStatus.published {
+      try visitor.visitSingularField(fieldType: PageStatus.self, value: status, protoFieldNumber: 2, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+    }
+    if publishAt != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: publishAt, protoFieldNumber: 3, protoFieldName: "publish_at", jsonFieldName: "publishAt", swiftFieldName: "publishAt")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageForkRequest) -> Bool {
+    if id != other.id {return false}
+    if status != other.status {return false}
+    if publishAt != other.publishAt {return false}
+    return true
+  }
+}
+
+public struct PageForksRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageForksRequest"}
+  public var protoMessageName: String {return "PageForksRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageForksRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct PageLink: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageLink"}
+  public var protoMessageName: String {return "PageLink"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "ref": 1,
+    "page": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "ref": 1,
+    "page": 2,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageLink
+    var _ref: String = ""
+    var _page: Page? = nil
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_ref)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _ref != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _ref, protoFieldNumber: 1, protoFieldName: "ref", jsonFieldName: "ref", swiftFieldName: "ref")
+      }
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _ref != other._ref {return false}
+      if _page != other._page {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._ref = _ref
+      clone._page = _page
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var ref: String {
+    get {return _storage._ref}
+    set {_uniqueStorage()._ref = newValue}
+  }
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageLink) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct PageLinksSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageLinksSet"}
+  public var protoMessageName: String {return "PageLinksSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "links": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "links": 1,
+  ]}
+
+  public var links: [PageLink] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageLink.self, value: &links)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !links.isEmpty {
+      try visitor.visitRepeatedMessageField(value: links, protoFieldNumber: 1, protoFieldName: "links", jsonFieldName: "links", swiftFieldName: "links")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageLinksSet) -> Bool {
+    if links != other.links {return false}
+    return true
+  }
+}
+
+public struct PageStatsRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageStatsRequest"}
+  public var protoMessageName: String {return "PageStatsRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "days": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "days": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var days: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &days)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if days != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: days, protoFieldNumber: 2, protoFieldName: "days", jsonFieldName: "days", swiftFieldName: "days")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageStatsRequest) -> Bool {
+    if id != other.id {return false}
+    if days != other.days {return false}
+    return true
+  }
+}
+
+public struct PageViewBucket: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageViewBucket"}
+  public var protoMessageName: String {return "PageViewBucket"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "day": 1,
+    "views": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "day": 1,
+    "views": 2,
+  ]}
+
+  public var day: Int64 = 0
+
+  public var views: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &day)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &views)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if day != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: day, protoFieldNumber: 1, protoFieldName: "day", jsonFieldName: "day", swiftFieldName: "day")
+    }
+    if views != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: views, protoFieldNumber: 2, protoFieldName: "views", jsonFieldName: "views", swiftFieldName: "views")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageViewBucket) -> Bool {
+    if day != other.day {return false}
+    if views != other.views {return false}
+    return true
+  }
+}
+
+public struct PageViewCount: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageViewCount"}
+  public var protoMessageName: String {return "PageViewCount"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "page": 1,
+    "views": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page": 1,
+    "views": 2,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageViewCount
+    var _page: Page? = nil
+    var _views: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_views)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 1, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+      if _views != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _views, protoFieldNumber: 2, protoFieldName: "views", jsonFieldName: "views", swiftFieldName: "views")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _page != other._page {return false}
+      if _views != other._views {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._page = _page
+      clone._views = _views
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public var views: Int64 {
+    get {return _storage._views}
+    set {_uniqueStorage()._views = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageViewCount) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct PageStatsResult: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageStatsResult"}
+  public var protoMessageName: String {return "PageStatsResult"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "days": 1,
+    "total": 2,
+    "topPages": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "days": 1,
+    "total": 2,
+    "top_pages": 3,
+  ]}
+
+  public var days: [PageViewBucket] = []
+
+  public var total: Int64 = 0
+
+  public var topPages: [PageViewCount] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageViewBucket.self, value: &days)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &total)
+    case 3: handled = try setter.decodeRepeatedMessageField(fieldType: PageViewCount.self, value: &topPages)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !days.isEmpty {
+      try visitor.visitRepeatedMessageField(value: days, protoFieldNumber: 1, protoFieldName: "days", jsonFieldName: "days", swiftFieldName: "days")
+    }
+    if total != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: total, protoFieldNumber: 2, protoFieldName: "total", jsonFieldName: "total", swiftFieldName: "total")
+    }
+    if !topPages.isEmpty {
+      try visitor.visitRepeatedMessageField(value: topPages, protoFieldNumber: 3, protoFieldName: "top_pages", jsonFieldName: "topPages", swiftFieldName: "topPages")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageStatsResult) -> Bool {
+    if days != other.days {return false}
+    if total != other.total {return false}
+    if topPages != other.topPages {return false}
+    return true
+  }
+}
+
+public struct PageWatchRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageWatchRequest"}
+  public var protoMessageName: String {return "PageWatchRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "accountId": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "account_id": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var accountId: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &accountId)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if accountId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: accountId, protoFieldNumber: 2, protoFieldName: "account_id", jsonFieldName: "accountId", swiftFieldName: "accountId")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageWatchRequest) -> Bool {
+    if id != other.id {return false}
+    if accountId != other.accountId {return false}
+    return true
+  }
+}
+
+public struct PageEvent: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageEvent"}
+  public var protoMessageName: String {return "PageEvent"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "type": 1,
+    "page": 2,
+    "created": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "type": 1,
+    "page": 2,
+    "created": 3,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageEvent
+    var _type: PageEventType = PageEventType.created
+    var _page: Page? = nil
+    var _created: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: PageEventType.self, value: &_type)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _type != PageEventType.created {
+        try visitor.visitSingularField(fieldType: PageEventType.self, value: _type, protoFieldNumber: 1, protoFieldName: "type", jsonFieldName: "type", swiftFieldName: "type")
+      }
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 3, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _type != other._type {return false}
+      if _page != other._page {return false}
+      if _created != other._created {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._type = _type
+      clone._page = _page
+      clone._created = _created
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var type: PageEventType {
+    get {return _storage._type}
+    set {_uniqueStorage()._type = newValue}
+  }
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageEvent) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct ChangesSinceRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ChangesSinceRequest"}
+  public var protoMessageName: String {return "ChangesSinceRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "cursor": 1,
+    "limit": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "cursor": 1,
+    "limit": 2,
+  ]}
+
+  public var cursor: Int64 = 0
+
+  public var limit: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &cursor)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &limit)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if cursor != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: cursor, protoFieldNumber: 1, protoFieldName: "cursor", jsonFieldName: "cursor", swiftFieldName: "cursor")
+    }
+    if limit != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: limit, protoFieldNumber: 2, protoFieldName: "limit", jsonFieldName: "limit", swiftFieldName: "limit")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ChangesSinceRequest) -> Bool {
+    if cursor != other.cursor {return false}
+    if limit != other.limit {return false}
+    return true
+  }
+}
+
+public struct PageChange: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageChange"}
+  public var protoMessageName: String {return "PageChange"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "sequence": 1,
+    "type": 2,
+    "pageId": 3,
+    "page": 4,
+    "created": 5,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "sequence": 1,
+    "type": 2,
+    "page_id": 3,
+    "page": 4,
+    "created": 5,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = PageChange
+    var _sequence: Int64 = 0
+    var _type: PageEventType = PageEventType.created
+    var _pageId: String = ""
+    var _page: Page? = nil
+    var _created: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_sequence)
+      case 2: handled = try setter.decodeSingularField(fieldType: PageEventType.self, value: &_type)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_pageId)
+      case 4: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _sequence != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _sequence, protoFieldNumber: 1, protoFieldName: "sequence", jsonFieldName: "sequence", swiftFieldName: "sequence")
+      }
+      if _type != PageEventType.created {
+        try visitor.visitSingularField(fieldType: PageEventType.self, value: _type, protoFieldNumber: 2, protoFieldName: "type", jsonFieldName: "type", swiftFieldName: "type")
+      }
+      if _pageId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _pageId, protoFieldNumber: 3, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+      }
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 4, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 5, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _sequence != other._sequence {return false}
+      if _type != other._type {return false}
+      if _pageId != other._pageId {return false}
+      if _page != other._page {return false}
+      if _created != other._created {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._sequence = _sequence
+      clone._type = _type
+      clone._pageId = _pageId
+      clone._page = _page
+      clone._created = _created
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var sequence: Int64 {
+    get {return _storage._sequence}
+    set {_uniqueStorage()._sequence = newValue}
+  }
+
+  public var type: PageEventType {
+    get {return _storage._type}
+    set {_uniqueStorage()._type = newValue}
+  }
+
+  public var pageId: String {
+    get {return _storage._pageId}
+    set {_uniqueStorage()._pageId = newValue}
+  }
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageChange) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct ChangesSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ChangesSet"}
+  public var protoMessageName: String {return "ChangesSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "changes": 1,
+    "cursor": 2,
+    "more": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "changes": 1,
+    "cursor": 2,
+    "more": 3,
+  ]}
+
+  public var changes: [PageChange] = []
+
+  public var cursor: Int64 = 0
+
+  public var more: Bool = false
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: PageChange.self, value: &changes)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &cursor)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &more)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !changes.isEmpty {
+      try visitor.visitRepeatedMessageField(value: changes, protoFieldNumber: 1, protoFieldName: "changes", jsonFieldName: "changes", swiftFieldName: "changes")
+    }
+    if cursor != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: cursor, protoFieldNumber: 2, protoFieldName: "cursor", jsonFieldName: "cursor", swiftFieldName: "cursor")
+    }
+    if more != false {
+      try visitor.visitSingularField(fieldType: ProtobufBool.self, value: more, protoFieldNumber: 3, protoFieldName: "more", jsonFieldName: "more", swiftFieldName: "more")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ChangesSet) -> Bool {
+    if changes != other.changes {return false}
+    if cursor != other.cursor {return false}
+    if more != other.more {return false}
+    return true
+  }
+}
+
+public struct SyncChange: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "SyncChange"}
+  public var protoMessageName: String {return "SyncChange"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "ref": 1,
+    "type": 2,
+    "pageId": 3,
+    "baseVersion": 4,
+    "text": 5,
+    "visibility": 6,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "ref": 1,
+    "type": 2,
+    "page_id": 3,
+    "base_version": 4,
+    "text": 5,
+    "visibility": 6,
+  ]}
+
+  public var ref: String = ""
+
+  public var type: PageEventType = PageEventType.created
+
+  public var pageId: String = ""
+
+  public var baseVersion: Int64 = 0
+
+  public var text: String = ""
+
+  public var visibility: Visibility = Visibility.private_
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &ref)
+    case 2: handled = try setter.decodeSingularField(fieldType: PageEventType.self, value: &type)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &pageId)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &baseVersion)
+    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    case 6: handled = try setter.decodeSingularField(fieldType: Visibility.self, value: &visibility)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if ref != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: ref, protoFieldNumber: 1, protoFieldName: "ref", jsonFieldName: "ref", swiftFieldName: "ref")
+    }
+    if type != PageEventType.created {
+      try visitor.visitSingularField(fieldType: PageEventType.self, value: type, protoFieldNumber: 2, protoFieldName: "type", jsonFieldName: "type", swiftFieldName: "type")
+    }
+    if pageId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: pageId, protoFieldNumber: 3, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+    }
+    if baseVersion != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: baseVersion, protoFieldNumber: 4, protoFieldName: "base_version", jsonFieldName: "baseVersion", swiftFieldName: "baseVersion")
+    }
+    if text != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 5, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    }
+    if visibility != Visibility.private_ {
+      try visitor.visitSingularField(fieldType: Visibility.self, value: visibility, protoFieldNumber: 6, protoFieldName: "visibility", jsonFieldName: "visibility", swiftFieldName: "visibility")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: SyncChange) -> Bool {
+    if ref != other.ref {return false}
+    if type != other.type {return false}
+    if pageId != other.pageId {return false}
+    if baseVersion != other.baseVersion {return false}
+    if text != other.text {return false}
+    if visibility != other.visibility {return false}
+    return true
+  }
+}
+
+public struct SyncRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "SyncRequest"}
+  public var protoMessageName: String {return "SyncRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "cursor": 1,
+    "changes": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "cursor": 1,
+    "changes": 2,
+  ]}
+
+  public var cursor: Int64 = 0
+
+  public var changes: [SyncChange] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &cursor)
+    case 2: handled = try setter.decodeRepeatedMessageField(fieldType: SyncChange.self, value: &changes)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if cursor != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: cursor, protoFieldNumber: 1, protoFieldName: "cursor", jsonFieldName: "cursor", swiftFieldName: "cursor")
+    }
+    if !changes.isEmpty {
+      try visitor.visitRepeatedMessageField(value: changes, protoFieldNumber: 2, protoFieldName: "changes", jsonFieldName: "changes", swiftFieldName: "changes")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: SyncRequest) -> Bool {
+    if cursor != other.cursor {return false}
+    if changes != other.changes {return false}
+    return true
+  }
+}
+
+public struct SyncResult: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "SyncResult"}
+  public var protoMessageName: String {return "SyncResult"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "ref": 1,
+    "outcome": 2,
+    "page": 3,
+    "error": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "ref": 1,
+    "outcome": 2,
+    "page": 3,
+    "error": 4,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = SyncResult
+    var _ref: String = ""
+    var _outcome: SyncOutcome = SyncOutcome.applied
+    var _page: Page? = nil
+    var _error: String = ""
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_ref)
+      case 2: handled = try setter.decodeSingularField(fieldType: SyncOutcome.self, value: &_outcome)
+      case 3: handled = try setter.decodeSingularMessageField(fieldType: Page.self, value: &_page)
+      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_error)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _ref != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _ref, protoFieldNumber: 1, protoFieldName: "ref", jsonFieldName: "ref", swiftFieldName: "ref")
+      }
+      if _outcome != SyncOutcome.applied {
+        try visitor.visitSingularField(fieldType: SyncOutcome.self, value: _outcome, protoFieldNumber: 2, protoFieldName: "outcome", jsonFieldName: "outcome", swiftFieldName: "outcome")
+      }
+      if let v = _page {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 3, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+      }
+      if _error != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _error, protoFieldNumber: 4, protoFieldName: "error", jsonFieldName: "error", swiftFieldName: "error")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _ref != other._ref {return false}
+      if _outcome != other._outcome {return false}
+      if _page != other._page {return false}
+      if _error != other._error {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._ref = _ref
+      clone._outcome = _outcome
+      clone._page = _page
+      clone._error = _error
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var ref: String {
+    get {return _storage._ref}
+    set {_uniqueStorage()._ref = newValue}
+  }
+
+  public var outcome: SyncOutcome {
+    get {return _storage._outcome}
+    set {_uniqueStorage()._outcome = newValue}
+  }
+
+  public var page: Page {
+    get {return _storage._page ?? Page()}
+    set {_uniqueStorage()._page = newValue}
+  }
+  public var hasPage: Bool {
+    return _storage._page != nil
+  }
+  public mutating func clearPage() {
+    return _storage._page = nil
+  }
+
+  public var error: String {
+    get {return _storage._error}
+    set {_uniqueStorage()._error = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: SyncResult) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct SyncResponse: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "SyncResponse"}
+  public var protoMessageName: String {return "SyncResponse"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "results": 1,
+    "changes": 2,
+    "cursor": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "results": 1,
+    "changes": 2,
+    "cursor": 3,
+  ]}
+
+  public var results: [SyncResult] = []
+
+  public var changes: [PageChange] = []
+
+  public var cursor: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: SyncResult.self, value: &results)
+    case 2: handled = try setter.decodeRepeatedMessageField(fieldType: PageChange.self, value: &changes)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &cursor)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !results.isEmpty {
+      try visitor.visitRepeatedMessageField(value: results, protoFieldNumber: 1, protoFieldName: "results", jsonFieldName: "results", swiftFieldName: "results")
+    }
+    if !changes.isEmpty {
+      try visitor.visitRepeatedMessageField(value: changes, protoFieldNumber: 2, protoFieldName: "changes", jsonFieldName: "changes", swiftFieldName: "changes")
+    }
+    if cursor != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: cursor, protoFieldNumber: 3, protoFieldName: "cursor", jsonFieldName: "cursor", swiftFieldName: "cursor")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: SyncResponse) -> Bool {
+    if results != other.results {return false}
+    if changes != other.changes {return false}
+    if cursor != other.cursor {return false}
+    return true
+  }
+}
+
+public struct Attachment: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Attachment"}
+  public var protoMessageName: String {return "Attachment"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "pageId": 2,
+    "name": 3,
+    "contentType": 4,
+    "size": 5,
+    "sha256": 6,
+    "created": 7,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "page_id": 2,
+    "name": 3,
+    "content_type": 4,
+    "size": 5,
+    "sha256": 6,
+    "created": 7,
+  ]}
+
+  public var id: String = ""
+
+  public var pageId: String = ""
+
+  public var name: String = ""
+
+  public var contentType: String = ""
+
+  public var size: Int64 = 0
+
+  public var sha256: String = ""
+
+  public var created: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &pageId)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &contentType)
+    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &size)
+    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &sha256)
+    case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &created)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if pageId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: pageId, protoFieldNumber: 2, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+    }
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 3, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
+    if contentType != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: contentType, protoFieldNumber: 4, protoFieldName: "content_type", jsonFieldName: "contentType", swiftFieldName: "contentType")
+    }
+    if size != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: size, protoFieldNumber: 5, protoFieldName: "size", jsonFieldName: "size", swiftFieldName: "size")
+    }
+    if sha256 != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: sha256, protoFieldNumber: 6, protoFieldName: "sha256", jsonFieldName: "sha256", swiftFieldName: "sha256")
+    }
+    if created != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: created, protoFieldNumber: 7, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: Attachment) -> Bool {
+    if id != other.id {return false}
+    if pageId != other.pageId {return false}
+    if name != other.name {return false}
+    if contentType != other.contentType {return false}
+    if size != other.size {return false}
+    if sha256 != other.sha256 {return false}
+    if created != other.created {return false}
+    return true
+  }
+}
+
+public struct AttachmentChunk: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "AttachmentChunk"}
+  public var protoMessageName: String {return "AttachmentChunk"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pageId": 1,
+    "name": 2,
+    "contentType": 3,
+    "size": 4,
+    "data": 5,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page_id": 1,
+    "name": 2,
+    "content_type": 3,
+    "size": 4,
+    "data": 5,
+  ]}
+
+  public var pageId: String = ""
+
+  public var name: String = ""
+
+  public var contentType: String = ""
+
+  public var size: Int64 = 0
+
+  public var data: Data = Data()
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &pageId)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &contentType)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &size)
+    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufBytes.self, value: &data)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if pageId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: pageId, protoFieldNumber: 1, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+    }
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 2, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
+    if contentType != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: contentType, protoFieldNumber: 3, protoFieldName: "content_type", jsonFieldName: "contentType", swiftFieldName: "contentType")
+    }
+    if size != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: size, protoFieldNumber: 4, protoFieldName: "size", jsonFieldName: "size", swiftFieldName: "size")
+    }
+    if data != Data() {
+      try visitor.visitSingularField(fieldType: ProtobufBytes.self, value: data, protoFieldNumber: 5, protoFieldName: "data", jsonFieldName: "data", swiftFieldName: "data")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: AttachmentChunk) -> Bool {
+    if pageId != other.pageId {return false}
+    if name != other.name {return false}
+    if contentType != other.contentType {return false}
+    if size != other.size {return false}
+    if data != other.data {return false}
+    return true
+  }
+}
+
+public struct AttachmentDownloadRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "AttachmentDownloadRequest"}
+  public var protoMessageName: String {return "AttachmentDownloadRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: AttachmentDownloadRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct Template: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Template"}
+  public var protoMessageName: String {return "Template"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "name": 3,
+    "text": 4,
+    "fields": 5,
+    "created": 6,
+    "modified": 7,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "name": 3,
+    "text": 4,
+    "fields": 5,
+    "created": 6,
+    "modified": 7,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Template
+    var _id: String = ""
+    var _account: Account? = nil
+    var _name: String = ""
+    var _text: String = ""
+    var _fields: [String] = []
+    var _created: Int64 = 0
+    var _modified: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_name)
+      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 5: handled = try setter.decodeRepeatedField(fieldType: ProtobufString.self, value: &_fields)
+      case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_modified)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _name != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _name, protoFieldNumber: 3, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 4, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if !_fields.isEmpty {
+        try visitor.visitRepeatedField(fieldType: ProtobufString.self, value: _fields, protoFieldNumber: 5, protoFieldName: "fields", jsonFieldName: "fields", swiftFieldName: "fields")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 6, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+      if _modified != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _modified, protoFieldNumber: 7, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _account != other._account {return false}
+      if _name != other._name {return false}
+      if _text != other._text {return false}
+      if _fields != other._fields {return false}
+      if _created != other._created {return false}
+      if _modified != other._modified {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._account = _account
+      clone._name = _name
+      clone._text = _text
+      clone._fields = _fields
+      clone._created = _created
+      clone._modified = _modified
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var name: String {
+    get {return _storage._name}
+    set {_uniqueStorage()._name = newValue}
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var fields: [String] {
+    get {return _storage._fields}
+    set {_uniqueStorage()._fields = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public var modified: Int64 {
+    get {return _storage._modified}
+    set {_uniqueStorage()._modified = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Template) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct TemplateCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "TemplateCreateRequest"}
+  public var protoMessageName: String {return "TemplateCreateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "name": 1,
+    "text": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "name": 1,
+    "text": 2,
+  ]}
+
+  public var name: String = ""
+
+  public var text: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 1, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
+    if text != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 2, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: TemplateCreateRequest) -> Bool {
+    if name != other.name {return false}
+    if text != other.text {return false}
+    return true
+  }
+}
+
+public struct TemplateDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "TemplateDeleteRequest"}
+  public var protoMessageName: String {return "TemplateDeleteRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: TemplateDeleteRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct TemplatesSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "TemplatesSet"}
+  public var protoMessageName: String {return "TemplatesSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "templates": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "templates": 1,
+  ]}
+
+  public var templates: [Template] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Template.self, value: &templates)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !templates.isEmpty {
+      try visitor.visitRepeatedMessageField(value: templates, protoFieldNumber: 1, protoFieldName: "templates", jsonFieldName: "templates", swiftFieldName: "templates")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: TemplatesSet) -> Bool {
+    if templates != other.templates {return false}
+    return true
+  }
+}
+
+public struct CommentAnchor: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentAnchor"}
+  public var protoMessageName: String {return "CommentAnchor"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "version": 1,
+    "start": 2,
+    "end": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "version": 1,
+    "start": 2,
+    "end": 3,
+  ]}
+
+  public var version: Int64 = 0
+
+  public var start: Int64 = 0
+
+  public var end: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &version)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &start)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &end)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if version != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: version, protoFieldNumber: 1, protoFieldName: "version", jsonFieldName: "version", swiftFieldName: "version")
+    }
+    if start != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: start, protoFieldNumber: 2, protoFieldName: "start", jsonFieldName: "start", swiftFieldName: "start")
+    }
+    if end != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: end, protoFieldNumber: 3, protoFieldName: "end", jsonFieldName: "end", swiftFieldName: "end")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentAnchor) -> Bool {
+    if version != other.version {return false}
+    if start != other.start {return false}
+    if end != other.end {return false}
+    return true
+  }
+}
+
+public struct Comment: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Comment"}
+  public var protoMessageName: String {return "Comment"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "pageId": 2,
+    "parentId": 3,
+    "account": 4,
+    "text": 5,
+    "anchor": 6,
+    "created": 7,
+    "modified": 8,
+    "deleted": 9,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "page_id": 2,
+    "parent_id": 3,
+    "account": 4,
+    "text": 5,
+    "anchor": 6,
+    "created": 7,
+    "modified": 8,
+    "deleted": 9,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Comment
+    var _id: String = ""
+    var _pageId: String = ""
+    var _parentId: String = ""
+    var _account: Account? = nil
+    var _text: String = ""
+    var _anchor: CommentAnchor? = nil
+    var _created: Int64 = 0
+    var _modified: Int64 = 0
+    var _deleted: Bool = false
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_pageId)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_parentId)
+      case 4: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 6: handled = try setter.decodeSingularMessageField(fieldType: CommentAnchor.self, value: &_anchor)
+      case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      case 8: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_modified)
+      case 9: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &_deleted)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if _pageId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _pageId, protoFieldNumber: 2, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+      }
+      if _parentId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _parentId, protoFieldNumber: 3, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 4, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 5, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if let v = _anchor {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 6, protoFieldName: "anchor", jsonFieldName: "anchor", swiftFieldName: "anchor")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 7, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+      if _modified != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _modified, protoFieldNumber: 8, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
+      }
+      if _deleted != false {
+        try visitor.visitSingularField(fieldType: ProtobufBool.self, value: _deleted, protoFieldNumber: 9, protoFieldName: "deleted", jsonFieldName: "deleted", swiftFieldName: "deleted")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _pageId != other._pageId {return false}
+      if _parentId != other._parentId {return false}
+      if _account != other._account {return false}
+      if _text != other._text {return false}
+      if _anchor != other._anchor {return false}
+      if _created != other._created {return false}
+      if _modified != other._modified {return false}
+      if _deleted != other._deleted {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._pageId = _pageId
+      clone._parentId = _parentId
+      clone._account = _account
+      clone._text = _text
+      clone._anchor = _anchor
+      clone._created = _created
+      clone._modified = _modified
+      clone._deleted = _deleted
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var pageId: String {
+    get {return _storage._pageId}
+    set {_uniqueStorage()._pageId = newValue}
+  }
+
+  public var parentId: String {
+    get {return _storage._parentId}
+    set {_uniqueStorage()._parentId = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var anchor: CommentAnchor {
+    get {return _storage._anchor ?? CommentAnchor()}
+    set {_uniqueStorage()._anchor = newValue}
+  }
+  public var hasAnchor: Bool {
+    return _storage._anchor != nil
+  }
+  public mutating func clearAnchor() {
+    return _storage._anchor = nil
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public var modified: Int64 {
+    get {return _storage._modified}
+    set {_uniqueStorage()._modified = newValue}
+  }
+
+  public var deleted: Bool {
+    get {return _storage._deleted}
+    set {_uniqueStorage()._deleted = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Comment) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct CommentCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentCreateRequest"}
+  public var protoMessageName: String {return "CommentCreateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pageId": 1,
+    "parentId": 2,
+    "text": 3,
+    "anchor": 4,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page_id": 1,
+    "parent_id": 2,
+    "text": 3,
+    "anchor": 4,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = CommentCreateRequest
+    var _pageId: String = ""
+    var _parentId: String = ""
+    var _text: String = ""
+    var _anchor: CommentAnchor? = nil
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_pageId)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_parentId)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 4: handled = try setter.decodeSingularMessageField(fieldType: CommentAnchor.self, value: &_anchor)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _pageId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _pageId, protoFieldNumber: 1, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+      }
+      if _parentId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _parentId, protoFieldNumber: 2, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 3, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if let v = _anchor {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 4, protoFieldName: "anchor", jsonFieldName: "anchor", swiftFieldName: "anchor")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _pageId != other._pageId {return false}
+      if _parentId != other._parentId {return false}
+      if _text != other._text {return false}
+      if _anchor != other._anchor {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._pageId = _pageId
+      clone._parentId = _parentId
+      clone._text = _text
+      clone._anchor = _anchor
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var pageId: String {
+    get {return _storage._pageId}
+    set {_uniqueStorage()._pageId = newValue}
+  }
+
+  public var parentId: String {
+    get {return _storage._parentId}
+    set {_uniqueStorage()._parentId = newValue}
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var anchor: CommentAnchor {
+    get {return _storage._anchor ?? CommentAnchor()}
+    set {_uniqueStorage()._anchor = newValue}
+  }
+  public var hasAnchor: Bool {
+    return _storage._anchor != nil
+  }
+  public mutating func clearAnchor() {
+    return _storage._anchor = nil
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentCreateRequest) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct CommentUpdateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentUpdateRequest"}
+  public var protoMessageName: String {return "CommentUpdateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "text": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "text": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var text: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if text != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 2, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentUpdateRequest) -> Bool {
+    if id != other.id {return false}
+    if text != other.text {return false}
+    return true
+  }
+}
+
+public struct CommentDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentDeleteRequest"}
+  public var protoMessageName: String {return "CommentDeleteRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+  ]}
+
+  public var id: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentDeleteRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct CommentListRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentListRequest"}
+  public var protoMessageName: String {return "CommentListRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pageId": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page_id": 1,
+  ]}
+
+  public var pageId: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &pageId)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if pageId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: pageId, protoFieldNumber: 1, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentListRequest) -> Bool {
+    if pageId != other.pageId {return false}
+    return true
+  }
+}
+
+public struct CommentsSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "CommentsSet"}
+  public var protoMessageName: String {return "CommentsSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "comments": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "comments": 1,
+  ]}
+
+  public var comments: [Comment] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Comment.self, value: &comments)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !comments.isEmpty {
+      try visitor.visitRepeatedMessageField(value: comments, protoFieldNumber: 1, protoFieldName: "comments", jsonFieldName: "comments", swiftFieldName: "comments")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: CommentsSet) -> Bool {
+    if comments != other.comments {return false}
+    return true
+  }
+}
+
+public struct ModerationDecision: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ModerationDecision"}
+  public var protoMessageName: String {return "ModerationDecision"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "pageId": 2,
+    "account": 3,
+    "action": 4,
+    "checker": 5,
+    "reason": 6,
+    "text": 7,
+    "created": 8,
+    "status": 9,
+    "publishAt": 10,
+    "verdict": 11,
+    "reviewerId": 12,
+    "reviewed": 13,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "page_id": 2,
+    "account": 3,
+    "action": 4,
+    "checker": 5,
+    "reason": 6,
+    "text": 7,
+    "created": 8,
+    "status": 9,
+    "publish_at": 10,
+    "verdict": 11,
+    "reviewer_id": 12,
+    "reviewed": 13,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = ModerationDecision
+    var _id: String = ""
+    var _pageId: String = ""
+    var _account: Account? = nil
+    var _action: ModerationAction = ModerationAction.allow
+    var _checker: String = ""
+    var _reason: String = ""
+    var _text: String = ""
+    var _created: Int64 = 0
+    var _status: PageStatus = PageStatus.published
+    var _publishAt: Int64 = 0
+    var _verdict: ReviewVerdict = ReviewVerdict.pending
+    var _reviewerId: String = ""
+    var _reviewed: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_pageId)
+      case 3: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 4: handled = try setter.decodeSingularField(fieldType: ModerationAction.self, value: &_action)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_checker)
+      case 6: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_reason)
+      case 7: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
+      case 8: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      case 9: handled = try setter.decodeSingularField(fieldType: PageStatus.self, value: &_status)
+      case 10: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_publishAt)
+      case 11: handled = try setter.decodeSingularField(fieldType: ReviewVerdict.self, value: &_verdict)
+      case 12: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_reviewerId)
+      case 13: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_reviewed)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if _pageId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _pageId, protoFieldNumber: 2, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 3, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _action != ModerationAction.allow {
+        try visitor.visitSingularField(fieldType: ModerationAction.self, value: _action, protoFieldNumber: 4, protoFieldName: "action", jsonFieldName: "action", swiftFieldName: "action")
+      }
+      if _checker != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _checker, protoFieldNumber: 5, protoFieldName: "checker", jsonFieldName: "checker", swiftFieldName: "checker")
+      }
+      if _reason != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _reason, protoFieldNumber: 6, protoFieldName: "reason", jsonFieldName: "reason", swiftFieldName: "reason")
+      }
+      if _text != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 7, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 8, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+      if _status != PageStatus.published {
+        try visitor.visitSingularField(fieldType: PageStatus.self, value: _status, protoFieldNumber: 9, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+      }
+      if _publishAt != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _publishAt, protoFieldNumber: 10, protoFieldName: "publish_at", jsonFieldName: "publishAt", swiftFieldName: "publishAt")
+      }
+      if _verdict != ReviewVerdict.pending {
+        try visitor.visitSingularField(fieldType: ReviewVerdict.self, value: _verdict, protoFieldNumber: 11, protoFieldName: "verdict", jsonFieldName: "verdict", swiftFieldName: "verdict")
+      }
+      if _reviewerId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _reviewerId, protoFieldNumber: 12, protoFieldName: "reviewer_id", jsonFieldName: "reviewerId", swiftFieldName: "reviewerId")
+      }
+      if _reviewed != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _reviewed, protoFieldNumber: 13, protoFieldName: "reviewed", jsonFieldName: "reviewed", swiftFieldName: "reviewed")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _pageId != other._pageId {return false}
+      if _account != other._account {return false}
+      if _action != other._action {return false}
+      if _checker != other._checker {return false}
+      if _reason != other._reason {return false}
+      if _text != other._text {return false}
+      if _created != other._created {return false}
+      if _status != other._status {return false}
+      if _publishAt != other._publishAt {return false}
+      if _verdict != other._verdict {return false}
+      if _reviewerId != other._reviewerId {return false}
+      if _reviewed != other._reviewed {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._pageId = _pageId
+      clone._account = _account
+      clone._action = _action
+      clone._checker = _checker
+      clone._reason = _reason
+      clone._text = _text
+      clone._created = _created
+      clone._status = _status
+      clone._publishAt = _publishAt
+      clone._verdict = _verdict
+      clone._reviewerId = _reviewerId
+      clone._reviewed = _reviewed
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var pageId: String {
+    get {return _storage._pageId}
+    set {_uniqueStorage()._pageId = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var action: ModerationAction {
+    get {return _storage._action}
+    set {_uniqueStorage()._action = newValue}
+  }
+
+  public var checker: String {
+    get {return _storage._checker}
+    set {_uniqueStorage()._checker = newValue}
+  }
+
+  public var reason: String {
+    get {return _storage._reason}
+    set {_uniqueStorage()._reason = newValue}
+  }
+
+  public var text: String {
+    get {return _storage._text}
+    set {_uniqueStorage()._text = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public var status: PageStatus {
+    get {return _storage._status}
+    set {_uniqueStorage()._status = newValue}
+  }
+
+  public var publishAt: Int64 {
+    get {return _storage._publishAt}
+    set {_uniqueStorage()._publishAt = newValue}
+  }
+
+  public var verdict: ReviewVerdict {
+    get {return _storage._verdict}
+    set {_uniqueStorage()._verdict = newValue}
+  }
+
+  public var reviewerId: String {
+    get {return _storage._reviewerId}
+    set {_uniqueStorage()._reviewerId = newValue}
+  }
+
+  public var reviewed: Int64 {
+    get {return _storage._reviewed}
+    set {_uniqueStorage()._reviewed = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: ModerationDecision) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct ModerationListRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ModerationListRequest"}
+  public var protoMessageName: String {return "ModerationListRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "pageId": 1,
+    "pending": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "page_id": 1,
+    "pending": 2,
+  ]}
+
+  public var pageId: String = ""
+
+  public var pending: Bool = false
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &pageId)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &pending)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if pageId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: pageId, protoFieldNumber: 1, protoFieldName: "page_id", jsonFieldName: "pageId", swiftFieldName: "pageId")
+    }
+    if pending != false {
+      try visitor.visitSingularField(fieldType: ProtobufBool.self, value: pending, protoFieldNumber: 2, protoFieldName: "pending", jsonFieldName: "pending", swiftFieldName: "pending")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ModerationListRequest) -> Bool {
+    if pageId != other.pageId {return false}
+    if pending != other.pending {return false}
+    return true
+  }
+}
+
+public struct ModerationReviewRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ModerationReviewRequest"}
+  public var protoMessageName: String {return "ModerationReviewRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "verdict": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "verdict": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var verdict: ReviewVerdict = ReviewVerdict.pending
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ReviewVerdict.self, value: &verdict)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if verdict != ReviewVerdict.pending {
+      try visitor.visitSingularField(fieldType: ReviewVerdict.self, value: verdict, protoFieldNumber: 2, protoFieldName: "verdict", jsonFieldName: "verdict", swiftFieldName: "verdict")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ModerationReviewRequest) -> Bool {
+    if id != other.id {return false}
+    if verdict != other.verdict {return false}
+    return true
+  }
+}
+
+public struct PageFlagRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageFlagRequest"}
+  public var protoMessageName: String {return "PageFlagRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "reason": 2,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "reason": 2,
+  ]}
+
+  public var id: String = ""
+
+  public var reason: String = ""
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &reason)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if reason != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: reason, protoFieldNumber: 2, protoFieldName: "reason", jsonFieldName: "reason", swiftFieldName: "reason")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: PageFlagRequest) -> Bool {
+    if id != other.id {return false}
+    if reason != other.reason {return false}
+    return true
+  }
+}
+
+public struct ModerationDecisionsSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "ModerationDecisionsSet"}
+  public var protoMessageName: String {return "ModerationDecisionsSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "decisions": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "decisions": 1,
+  ]}
+
+  public var decisions: [ModerationDecision] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: ModerationDecision.self, value: &decisions)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !decisions.isEmpty {
+      try visitor.visitRepeatedMessageField(value: decisions, protoFieldNumber: 1, protoFieldName: "decisions", jsonFieldName: "decisions", swiftFieldName: "decisions")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: ModerationDecisionsSet) -> Bool {
+    if decisions != other.decisions {return false}
+    return true
+  }
+}
+
+public struct Webhook: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Webhook"}
+  public var protoMessageName: String {return "Webhook"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "url": 3,
+    "events": 4,
+    "secret": 5,
+    "created": 6,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "url": 3,
+    "events": 4,
+    "secret": 5,
+    "created": 6,
+  ]}
+
+  private class _StorageClass {
+    typealias ProtobufExtendedMessage = Webhook
+    var _id: String = ""
+    var _account: Account? = nil
+    var _url: String = ""
+    var _events: [PageEventType] = []
+    var _secret: String = ""
+    var _created: Int64 = 0
+
+    init() {}
+
+    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+      let handled: Bool
+      switch protoFieldNumber {
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_url)
+      case 4: handled = try setter.decodeRepeatedField(fieldType: PageEventType.self, value: &_events)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_secret)
+      case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      default:
+        handled = false
+      }
+      return handled
+    }
+
+    func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
+      if let v = _account {
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+      }
+      if _url != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _url, protoFieldNumber: 3, protoFieldName: "url", jsonFieldName: "url", swiftFieldName: "url")
+      }
+      if !_events.isEmpty {
+        try visitor.visitPackedField(fieldType: PageEventType.self, value: _events, protoFieldNumber: 4, protoFieldName: "events", jsonFieldName: "events", swiftFieldName: "events")
+      }
+      if _secret != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _secret, protoFieldNumber: 5, protoFieldName: "secret", jsonFieldName: "secret", swiftFieldName: "secret")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 6, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+    }
+
+    func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
+      if _account != other._account {return false}
+      if _url != other._url {return false}
+      if _events != other._events {return false}
+      if _secret != other._secret {return false}
+      if _created != other._created {return false}
+      return true
+    }
+
+    func copy() -> _StorageClass {
+      let clone = _StorageClass()
+      clone._id = _id
+      clone._account = _account
+      clone._url = _url
+      clone._events = _events
+      clone._secret = _secret
+      clone._created = _created
+      return clone
+    }
+  }
+
+  private var _storage = _StorageClass()
+
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
+  public var account: Account {
+    get {return _storage._account ?? Account()}
+    set {_uniqueStorage()._account = newValue}
+  }
+  public var hasAccount: Bool {
+    return _storage._account != nil
+  }
+  public mutating func clearAccount() {
+    return _storage._account = nil
+  }
+
+  public var url: String {
+    get {return _storage._url}
+    set {_uniqueStorage()._url = newValue}
+  }
+
+  public var events: [PageEventType] {
+    get {return _storage._events}
+    set {_uniqueStorage()._events = newValue}
+  }
+
+  public var secret: String {
+    get {return _storage._secret}
+    set {_uniqueStorage()._secret = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    try _storage.traverse(visitor: &visitor)
+  }
+
+  public func _protoc_generated_isEqualTo(other: Webhook) -> Bool {
+    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+  }
+
+  private mutating func _uniqueStorage() -> _StorageClass {
+    if !isKnownUniquelyReferenced(&_storage) {
+      _storage = _storage.copy()
+    }
+    return _storage
+  }
+}
+
+public struct WebhookCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhookCreateRequest"}
+  public var protoMessageName: String {return "WebhookCreateRequest"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "url": 1,
+    "events": 2,
+    "secret": 3,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "url": 1,
+    "events": 2,
+    "secret": 3,
+  ]}
+
+  public var url: String = ""
+
+  public var events: [PageEventType] = []
+
+  public var secret: String = ""
 
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
-    return false // Proto3 ignores unknown fields
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &url)
+    case 2: handled = try setter.decodeRepeatedField(fieldType: PageEventType.self, value: &events)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &secret)
+    default:
+      handled = false
+    }
+    return handled
   }
 
   public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if url != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: url, protoFieldNumber: 1, protoFieldName: "url", jsonFieldName: "url", swiftFieldName: "url")
+    }
+    if !events.isEmpty {
+      try visitor.visitPackedField(fieldType: PageEventType.self, value: events, protoFieldNumber: 2, protoFieldName: "events", jsonFieldName: "events", swiftFieldName: "events")
+    }
+    if secret != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: secret, protoFieldNumber: 3, protoFieldName: "secret", jsonFieldName: "secret", swiftFieldName: "secret")
+    }
   }
 
-  public func _protoc_generated_isEqualTo(other: Empty) -> Bool {
+  public func _protoc_generated_isEqualTo(other: WebhookCreateRequest) -> Bool {
+    if url != other.url {return false}
+    if events != other.events {return false}
+    if secret != other.secret {return false}
     return true
   }
 }
 
-public struct Account: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "Account"}
-  public var protoMessageName: String {return "Account"}
+public struct WebhookDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhookDeleteRequest"}
+  public var protoMessageName: String {return "WebhookDeleteRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
     "id": 1,
-    "name": 2,
-    "email": 3,
-    "created": 5,
-    "modified": 6,
   ]}
   public var protoFieldNames: [String: Int] {return [
     "id": 1,
-    "name": 2,
-    "email": 3,
-    "created": 5,
-    "modified": 6,
   ]}
 
   public var id: String = ""
 
-  public var name: String = ""
+  public init() {}
 
-  public var email: String = ""
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: WebhookDeleteRequest) -> Bool {
+    if id != other.id {return false}
+    return true
+  }
+}
+
+public struct WebhooksSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhooksSet"}
+  public var protoMessageName: String {return "WebhooksSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "webhooks": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "webhooks": 1,
+  ]}
+
+  public var webhooks: [Webhook] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Webhook.self, value: &webhooks)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !webhooks.isEmpty {
+      try visitor.visitRepeatedMessageField(value: webhooks, protoFieldNumber: 1, protoFieldName: "webhooks", jsonFieldName: "webhooks", swiftFieldName: "webhooks")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: WebhooksSet) -> Bool {
+    if webhooks != other.webhooks {return false}
+    return true
+  }
+}
+
+public struct WebhookDelivery: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhookDelivery"}
+  public var protoMessageName: String {return "WebhookDelivery"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "webhookId": 2,
+    "event": 3,
+    "payload": 4,
+    "status": 5,
+    "attempts": 6,
+    "nextAttempt": 7,
+    "responseCode": 8,
+    "error": 9,
+    "created": 10,
+    "modified": 11,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "webhook_id": 2,
+    "event": 3,
+    "payload": 4,
+    "status": 5,
+    "attempts": 6,
+    "next_attempt": 7,
+    "response_code": 8,
+    "error": 9,
+    "created": 10,
+    "modified": 11,
+  ]}
+
+  public var id: String = ""
+
+  public var webhookId: String = ""
+
+  public var event: PageEventType = PageEventType.created
+
+  public var payload: String = ""
+
+  public var status: DeliveryStatus = DeliveryStatus.queued
+
+  public var attempts: Int64 = 0
+
+  public var nextAttempt: Int64 = 0
+
+  public var responseCode: Int32 = 0
+
+  public var error: String = ""
 
   public var created: Int64 = 0
 
@@ -66,10 +6032,16 @@ public struct Account: ProtobufGeneratedMessage {
     let handled: Bool
     switch protoFieldNumber {
     case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
-    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
-    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
-    case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &created)
-    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &modified)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &webhookId)
+    case 3: handled = try setter.decodeSingularField(fieldType: PageEventType.self, value: &event)
+    case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &payload)
+    case 5: handled = try setter.decodeSingularField(fieldType: DeliveryStatus.self, value: &status)
+    case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &attempts)
+    case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &nextAttempt)
+    case 8: handled = try setter.decodeSingularField(fieldType: ProtobufInt32.self, value: &responseCode)
+    case 9: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &error)
+    case 10: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &created)
+    case 11: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &modified)
     default:
       handled = false
     }
@@ -80,55 +6052,182 @@ public struct Account: ProtobufGeneratedMessage {
     if id != "" {
       try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
     }
-    if name != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 2, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    if webhookId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: webhookId, protoFieldNumber: 2, protoFieldName: "webhook_id", jsonFieldName: "webhookId", swiftFieldName: "webhookId")
     }
-    if email != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 3, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
+    if event != PageEventType.created {
+      try visitor.visitSingularField(fieldType: PageEventType.self, value: event, protoFieldNumber: 3, protoFieldName: "event", jsonFieldName: "event", swiftFieldName: "event")
+    }
+    if payload != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: payload, protoFieldNumber: 4, protoFieldName: "payload", jsonFieldName: "payload", swiftFieldName: "payload")
+    }
+    if status != DeliveryStatus.queued {
+      try visitor.visitSingularField(fieldType: DeliveryStatus.self, value: status, protoFieldNumber: 5, protoFieldName: "status", jsonFieldName: "status", swiftFieldName: "status")
+    }
+    if attempts != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: attempts, protoFieldNumber: 6, protoFieldName: "attempts", jsonFieldName: "attempts", swiftFieldName: "attempts")
+    }
+    if nextAttempt != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: nextAttempt, protoFieldNumber: 7, protoFieldName: "next_attempt", jsonFieldName: "nextAttempt", swiftFieldName: "nextAttempt")
+    }
+    if responseCode != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt32.self, value: responseCode, protoFieldNumber: 8, protoFieldName: "response_code", jsonFieldName: "responseCode", swiftFieldName: "responseCode")
+    }
+    if error != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: error, protoFieldNumber: 9, protoFieldName: "error", jsonFieldName: "error", swiftFieldName: "error")
     }
     if created != 0 {
-      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: created, protoFieldNumber: 5, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: created, protoFieldNumber: 10, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
     }
     if modified != 0 {
-      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: modified, protoFieldNumber: 6, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: modified, protoFieldNumber: 11, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
     }
   }
 
-  public func _protoc_generated_isEqualTo(other: Account) -> Bool {
+  public func _protoc_generated_isEqualTo(other: WebhookDelivery) -> Bool {
     if id != other.id {return false}
-    if name != other.name {return false}
-    if email != other.email {return false}
+    if webhookId != other.webhookId {return false}
+    if event != other.event {return false}
+    if payload != other.payload {return false}
+    if status != other.status {return false}
+    if attempts != other.attempts {return false}
+    if nextAttempt != other.nextAttempt {return false}
+    if responseCode != other.responseCode {return false}
+    if error != other.error {return false}
     if created != other.created {return false}
     if modified != other.modified {return false}
     return true
   }
 }
 
-public struct Session: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "Session"}
-  public var protoMessageName: String {return "Session"}
+public struct WebhookDeliveriesRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhookDeliveriesRequest"}
+  public var protoMessageName: String {return "WebhookDeliveriesRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
-    "account": 1,
-    "token": 2,
+    "webhookId": 1,
+    "limit": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
-    "account": 1,
-    "token": 2,
+    "webhook_id": 1,
+    "limit": 2,
+  ]}
+
+  public var webhookId: String = ""
+
+  public var limit: Int64 = 0
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &webhookId)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &limit)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if webhookId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: webhookId, protoFieldNumber: 1, protoFieldName: "webhook_id", jsonFieldName: "webhookId", swiftFieldName: "webhookId")
+    }
+    if limit != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: limit, protoFieldNumber: 2, protoFieldName: "limit", jsonFieldName: "limit", swiftFieldName: "limit")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: WebhookDeliveriesRequest) -> Bool {
+    if webhookId != other.webhookId {return false}
+    if limit != other.limit {return false}
+    return true
+  }
+}
+
+public struct WebhookDeliveriesSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "WebhookDeliveriesSet"}
+  public var protoMessageName: String {return "WebhookDeliveriesSet"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "deliveries": 1,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "deliveries": 1,
+  ]}
+
+  public var deliveries: [WebhookDelivery] = []
+
+  public init() {}
+
+  public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: WebhookDelivery.self, value: &deliveries)
+    default:
+      handled = false
+    }
+    return handled
+  }
+
+  public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
+    if !deliveries.isEmpty {
+      try visitor.visitRepeatedMessageField(value: deliveries, protoFieldNumber: 1, protoFieldName: "deliveries", jsonFieldName: "deliveries", swiftFieldName: "deliveries")
+    }
+  }
+
+  public func _protoc_generated_isEqualTo(other: WebhookDeliveriesSet) -> Bool {
+    if deliveries != other.deliveries {return false}
+    return true
+  }
+}
+
+public struct Notebook: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "Notebook"}
+  public var protoMessageName: String {return "Notebook"}
+  public var protoPackageName: String {return ""}
+  public var jsonFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "name": 3,
+    "parentId": 4,
+    "position": 5,
+    "created": 6,
+    "modified": 7,
+  ]}
+  public var protoFieldNames: [String: Int] {return [
+    "id": 1,
+    "account": 2,
+    "name": 3,
+    "parent_id": 4,
+    "position": 5,
+    "created": 6,
+    "modified": 7,
   ]}
 
   private class _StorageClass {
-    typealias ProtobufExtendedMessage = Session
+    typealias ProtobufExtendedMessage = Notebook
+    var _id: String = ""
     var _account: Account? = nil
-    var _token: String = ""
+    var _name: String = ""
+    var _parentId: String = ""
+    var _position: Int64 = 0
+    var _created: Int64 = 0
+    var _modified: Int64 = 0
 
     init() {}
 
     func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
       let handled: Bool
       switch protoFieldNumber {
-      case 1: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
-      case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_token)
+      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
+      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
+      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_name)
+      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_parentId)
+      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_position)
+      case 6: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
+      case 7: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_modified)
       default:
         handled = false
       }
@@ -136,30 +6235,60 @@ public struct Session: ProtobufGeneratedMessage {
     }
 
     func traverse(visitor: inout ProtobufVisitor) throws {
+      if _id != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+      }
       if let v = _account {
-        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 1, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
+        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
       }
-      if _token != "" {
-        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _token, protoFieldNumber: 2, protoFieldName: "token", jsonFieldName: "token", swiftFieldName: "token")
+      if _name != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _name, protoFieldNumber: 3, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+      }
+      if _parentId != "" {
+        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _parentId, protoFieldNumber: 4, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
+      }
+      if _position != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _position, protoFieldNumber: 5, protoFieldName: "position", jsonFieldName: "position", swiftFieldName: "position")
+      }
+      if _created != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 6, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
+      }
+      if _modified != 0 {
+        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _modified, protoFieldNumber: 7, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
       }
     }
 
     func isEqualTo(other: _StorageClass) -> Bool {
+      if _id != other._id {return false}
       if _account != other._account {return false}
-      if _token != other._token {return false}
+      if _name != other._name {return false}
+      if _parentId != other._parentId {return false}
+      if _position != other._position {return false}
+      if _created != other._created {return false}
+      if _modified != other._modified {return false}
       return true
     }
 
     func copy() -> _StorageClass {
       let clone = _StorageClass()
+      clone._id = _id
       clone._account = _account
-      clone._token = _token
+      clone._name = _name
+      clone._parentId = _parentId
+      clone._position = _position
+      clone._created = _created
+      clone._modified = _modified
       return clone
     }
   }
 
   private var _storage = _StorageClass()
 
+  public var id: String {
+    get {return _storage._id}
+    set {_uniqueStorage()._id = newValue}
+  }
+
   public var account: Account {
     get {return _storage._account ?? Account()}
     set {_uniqueStorage()._account = newValue}
@@ -171,9 +6300,29 @@ public struct Session: ProtobufGeneratedMessage {
     return _storage._account = nil
   }
 
-  public var token: String {
-    get {return _storage._token}
-    set {_uniqueStorage()._token = newValue}
+  public var name: String {
+    get {return _storage._name}
+    set {_uniqueStorage()._name = newValue}
+  }
+
+  public var parentId: String {
+    get {return _storage._parentId}
+    set {_uniqueStorage()._parentId = newValue}
+  }
+
+  public var position: Int64 {
+    get {return _storage._position}
+    set {_uniqueStorage()._position = newValue}
+  }
+
+  public var created: Int64 {
+    get {return _storage._created}
+    set {_uniqueStorage()._created = newValue}
+  }
+
+  public var modified: Int64 {
+    get {return _storage._modified}
+    set {_uniqueStorage()._modified = newValue}
   }
 
   public init() {}
@@ -186,7 +6335,7 @@ public struct Session: ProtobufGeneratedMessage {
     try _storage.traverse(visitor: &visitor)
   }
 
-  public func _protoc_generated_isEqualTo(other: Session) -> Bool {
+  public func _protoc_generated_isEqualTo(other: Notebook) -> Bool {
     return _storage === other._storage || _storage.isEqualTo(other: other._storage)
   }
 
@@ -198,26 +6347,22 @@ public struct Session: ProtobufGeneratedMessage {
   }
 }
 
-public struct RegisterRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "RegisterRequest"}
-  public var protoMessageName: String {return "RegisterRequest"}
+public struct NotebookCreateRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookCreateRequest"}
+  public var protoMessageName: String {return "NotebookCreateRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
     "name": 1,
-    "email": 2,
-    "password": 3,
+    "parentId": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
     "name": 1,
-    "email": 2,
-    "password": 3,
+    "parent_id": 2,
   ]}
 
   public var name: String = ""
 
-  public var email: String = ""
-
-  public var password: String = ""
+  public var parentId: String = ""
 
   public init() {}
 
@@ -225,8 +6370,7 @@ public struct RegisterRequest: ProtobufGeneratedMessage {
     let handled: Bool
     switch protoFieldNumber {
     case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
-    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &email)
-    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &password)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &parentId)
     default:
       handled = false
     }
@@ -237,46 +6381,42 @@ public struct RegisterRequest: ProtobufGeneratedMessage {
     if name != "" {
       try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 1, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
     }
-    if email != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: email, protoFieldNumber: 2, protoFieldName: "email", jsonFieldName: "email", swiftFieldName: "email")
-    }
-    if password != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: password, protoFieldNumber: 3, protoFieldName: "password", jsonFieldName: "password", swiftFieldName: "password")
+    if parentId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: parentId, protoFieldNumber: 2, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
     }
   }
 
-  public func _protoc_generated_isEqualTo(other: RegisterRequest) -> Bool {
+  public func _protoc_generated_isEqualTo(other: NotebookCreateRequest) -> Bool {
     if name != other.name {return false}
-    if email != other.email {return false}
-    if password != other.password {return false}
+    if parentId != other.parentId {return false}
     return true
   }
 }
 
-public struct ConnectRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "ConnectRequest"}
-  public var protoMessageName: String {return "ConnectRequest"}
+public struct NotebookListRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookListRequest"}
+  public var protoMessageName: String {return "NotebookListRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
-    "identifier": 1,
-    "password": 2,
+    "parentId": 1,
+    "recursive": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
-    "identifier": 1,
-    "password": 2,
+    "parent_id": 1,
+    "recursive": 2,
   ]}
 
-  public var identifier: String = ""
+  public var parentId: String = ""
 
-  public var password: String = ""
+  public var recursive: Bool = false
 
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
-    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &identifier)
-    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &password)
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &parentId)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &recursive)
     default:
       handled = false
     }
@@ -284,40 +6424,45 @@ public struct ConnectRequest: ProtobufGeneratedMessage {
   }
 
   public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
-    if identifier != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: identifier, protoFieldNumber: 1, protoFieldName: "identifier", jsonFieldName: "identifier", swiftFieldName: "identifier")
+    if parentId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: parentId, protoFieldNumber: 1, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
     }
-    if password != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: password, protoFieldNumber: 2, protoFieldName: "password", jsonFieldName: "password", swiftFieldName: "password")
+    if recursive != false {
+      try visitor.visitSingularField(fieldType: ProtobufBool.self, value: recursive, protoFieldNumber: 2, protoFieldName: "recursive", jsonFieldName: "recursive", swiftFieldName: "recursive")
     }
   }
 
-  public func _protoc_generated_isEqualTo(other: ConnectRequest) -> Bool {
-    if identifier != other.identifier {return false}
-    if password != other.password {return false}
+  public func _protoc_generated_isEqualTo(other: NotebookListRequest) -> Bool {
+    if parentId != other.parentId {return false}
+    if recursive != other.recursive {return false}
     return true
   }
 }
 
-public struct PageGetRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "PageGetRequest"}
-  public var protoMessageName: String {return "PageGetRequest"}
+public struct NotebookRenameRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookRenameRequest"}
+  public var protoMessageName: String {return "NotebookRenameRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
     "id": 1,
+    "name": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
     "id": 1,
+    "name": 2,
   ]}
 
   public var id: String = ""
 
+  public var name: String = ""
+
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
     case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &name)
     default:
       handled = false
     }
@@ -328,33 +6473,47 @@ public struct PageGetRequest: ProtobufGeneratedMessage {
     if id != "" {
       try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
     }
+    if name != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: name, protoFieldNumber: 2, protoFieldName: "name", jsonFieldName: "name", swiftFieldName: "name")
+    }
   }
 
-  public func _protoc_generated_isEqualTo(other: PageGetRequest) -> Bool {
+  public func _protoc_generated_isEqualTo(other: NotebookRenameRequest) -> Bool {
     if id != other.id {return false}
+    if name != other.name {return false}
     return true
   }
 }
 
-public struct PageCreateRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "PageCreateRequest"}
-  public var protoMessageName: String {return "PageCreateRequest"}
+public struct NotebookMoveRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookMoveRequest"}
+  public var protoMessageName: String {return "NotebookMoveRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
-    "text": 1,
+    "id": 1,
+    "parentId": 2,
+    "position": 3,
   ]}
   public var protoFieldNames: [String: Int] {return [
-    "text": 1,
+    "id": 1,
+    "parent_id": 2,
+    "position": 3,
   ]}
 
-  public var text: String = ""
+  public var id: String = ""
+
+  public var parentId: String = ""
+
+  public var position: Int64 = 0
 
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
-    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &parentId)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &position)
     default:
       handled = false
     }
@@ -362,41 +6521,44 @@ public struct PageCreateRequest: ProtobufGeneratedMessage {
   }
 
   public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
-    if text != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 1, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
+    }
+    if parentId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: parentId, protoFieldNumber: 2, protoFieldName: "parent_id", jsonFieldName: "parentId", swiftFieldName: "parentId")
+    }
+    if position != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: position, protoFieldNumber: 3, protoFieldName: "position", jsonFieldName: "position", swiftFieldName: "position")
     }
   }
 
-  public func _protoc_generated_isEqualTo(other: PageCreateRequest) -> Bool {
-    if text != other.text {return false}
+  public func _protoc_generated_isEqualTo(other: NotebookMoveRequest) -> Bool {
+    if id != other.id {return false}
+    if parentId != other.parentId {return false}
+    if position != other.position {return false}
     return true
   }
 }
 
-public struct PageUpdateRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "PageUpdateRequest"}
-  public var protoMessageName: String {return "PageUpdateRequest"}
+public struct NotebookDeleteRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookDeleteRequest"}
+  public var protoMessageName: String {return "NotebookDeleteRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
     "id": 1,
-    "text": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
     "id": 1,
-    "text": 2,
   ]}
 
   public var id: String = ""
 
-  public var text: String = ""
-
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
     case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
-    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &text)
     default:
       handled = false
     }
@@ -407,37 +6569,38 @@ public struct PageUpdateRequest: ProtobufGeneratedMessage {
     if id != "" {
       try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
     }
-    if text != "" {
-      try visitor.visitSingularField(fieldType: ProtobufString.self, value: text, protoFieldNumber: 2, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
-    }
   }
 
-  public func _protoc_generated_isEqualTo(other: PageUpdateRequest) -> Bool {
+  public func _protoc_generated_isEqualTo(other: NotebookDeleteRequest) -> Bool {
     if id != other.id {return false}
-    if text != other.text {return false}
     return true
   }
 }
 
-public struct PageDeleteRequest: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "PageDeleteRequest"}
-  public var protoMessageName: String {return "PageDeleteRequest"}
+public struct NotebookPagesRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebookPagesRequest"}
+  public var protoMessageName: String {return "NotebookPagesRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
     "id": 1,
+    "recursive": 2,
   ]}
   public var protoFieldNames: [String: Int] {return [
     "id": 1,
+    "recursive": 2,
   ]}
 
   public var id: String = ""
 
+  public var recursive: Bool = false
+
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
     case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufBool.self, value: &recursive)
     default:
       handled = false
     }
@@ -448,179 +6611,84 @@ public struct PageDeleteRequest: ProtobufGeneratedMessage {
     if id != "" {
       try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
     }
+    if recursive != false {
+      try visitor.visitSingularField(fieldType: ProtobufBool.self, value: recursive, protoFieldNumber: 2, protoFieldName: "recursive", jsonFieldName: "recursive", swiftFieldName: "recursive")
+    }
   }
 
-  public func _protoc_generated_isEqualTo(other: PageDeleteRequest) -> Bool {
+  public func _protoc_generated_isEqualTo(other: NotebookPagesRequest) -> Bool {
     if id != other.id {return false}
+    if recursive != other.recursive {return false}
     return true
   }
 }
 
-public struct Page: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "Page"}
-  public var protoMessageName: String {return "Page"}
+public struct NotebooksSet: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "NotebooksSet"}
+  public var protoMessageName: String {return "NotebooksSet"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
-    "id": 1,
-    "account": 2,
-    "text": 3,
-    "created": 4,
-    "modified": 5,
+    "notebooks": 1,
   ]}
   public var protoFieldNames: [String: Int] {return [
-    "id": 1,
-    "account": 2,
-    "text": 3,
-    "created": 4,
-    "modified": 5,
+    "notebooks": 1,
   ]}
 
-  private class _StorageClass {
-    typealias ProtobufExtendedMessage = Page
-    var _id: String = ""
-    var _account: Account? = nil
-    var _text: String = ""
-    var _created: Int64 = 0
-    var _modified: Int64 = 0
-
-    init() {}
-
-    func decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
-      let handled: Bool
-      switch protoFieldNumber {
-      case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_id)
-      case 2: handled = try setter.decodeSingularMessageField(fieldType: Account.self, value: &_account)
-      case 3: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &_text)
-      case 4: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_created)
-      case 5: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &_modified)
-      default:
-        handled = false
-      }
-      return handled
-    }
-
-    func traverse(visitor: inout ProtobufVisitor) throws {
-      if _id != "" {
-        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
-      }
-      if let v = _account {
-        try visitor.visitSingularMessageField(value: v, protoFieldNumber: 2, protoFieldName: "account", jsonFieldName: "account", swiftFieldName: "account")
-      }
-      if _text != "" {
-        try visitor.visitSingularField(fieldType: ProtobufString.self, value: _text, protoFieldNumber: 3, protoFieldName: "text", jsonFieldName: "text", swiftFieldName: "text")
-      }
-      if _created != 0 {
-        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _created, protoFieldNumber: 4, protoFieldName: "created", jsonFieldName: "created", swiftFieldName: "created")
-      }
-      if _modified != 0 {
-        try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: _modified, protoFieldNumber: 5, protoFieldName: "modified", jsonFieldName: "modified", swiftFieldName: "modified")
-      }
-    }
-
-    func isEqualTo(other: _StorageClass) -> Bool {
-      if _id != other._id {return false}
-      if _account != other._account {return false}
-      if _text != other._text {return false}
-      if _created != other._created {return false}
-      if _modified != other._modified {return false}
-      return true
-    }
-
-    func copy() -> _StorageClass {
-      let clone = _StorageClass()
-      clone._id = _id
-      clone._account = _account
-      clone._text = _text
-      clone._created = _created
-      clone._modified = _modified
-      return clone
-    }
-  }
-
-  private var _storage = _StorageClass()
-
-  public var id: String {
-    get {return _storage._id}
-    set {_uniqueStorage()._id = newValue}
-  }
-
-  public var account: Account {
-    get {return _storage._account ?? Account()}
-    set {_uniqueStorage()._account = newValue}
-  }
-  public var hasAccount: Bool {
-    return _storage._account != nil
-  }
-  public mutating func clearAccount() {
-    return _storage._account = nil
-  }
-
-  public var text: String {
-    get {return _storage._text}
-    set {_uniqueStorage()._text = newValue}
-  }
-
-  public var created: Int64 {
-    get {return _storage._created}
-    set {_uniqueStorage()._created = newValue}
-  }
-
-  public var modified: Int64 {
-    get {return _storage._modified}
-    set {_uniqueStorage()._modified = newValue}
-  }
+  public var notebooks: [Notebook] = []
 
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
-    return try _uniqueStorage().decodeField(setter: &setter, protoFieldNumber: protoFieldNumber)
+    let handled: Bool
+    switch protoFieldNumber {
+    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Notebook.self, value: &notebooks)
+    default:
+      handled = false
+    }
+    return handled
   }
 
   public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
-    try _storage.traverse(visitor: &visitor)
-  }
-
-  public func _protoc_generated_isEqualTo(other: Page) -> Bool {
-    return _storage === other._storage || _storage.isEqualTo(other: other._storage)
+    if !notebooks.isEmpty {
+      try visitor.visitRepeatedMessageField(value: notebooks, protoFieldNumber: 1, protoFieldName: "notebooks", jsonFieldName: "notebooks", swiftFieldName: "notebooks")
+    }
   }
 
-  private mutating func _uniqueStorage() -> _StorageClass {
-    if !isKnownUniquelyReferenced(&_storage) {
-      _storage = _storage.copy()
-    }
-    return _storage
+  public func _protoc_generated_isEqualTo(other: NotebooksSet) -> Bool {
+    if notebooks != other.notebooks {return false}
+    return true
   }
 }
 
-public struct PagesSet: ProtobufGeneratedMessage {
-  public var swiftClassName: String {return "PagesSet"}
-  public var protoMessageName: String {return "PagesSet"}
+public struct PageMoveRequest: ProtobufGeneratedMessage {
+  public var swiftClassName: String {return "PageMoveRequest"}
+  public var protoMessageName: String {return "PageMoveRequest"}
   public var protoPackageName: String {return ""}
   public var jsonFieldNames: [String: Int] {return [
-    "pages": 1,
-    "total": 2,
-    "page": 3,
+    "id": 1,
+    "notebookId": 2,
+    "position": 3,
   ]}
   public var protoFieldNames: [String: Int] {return [
-    "pages": 1,
-    "total": 2,
-    "page": 3,
+    "id": 1,
+    "notebook_id": 2,
+    "position": 3,
   ]}
 
-  public var pages: [Page] = []
+  public var id: String = ""
 
-  public var total: Int64 = 0
+  public var notebookId: String = ""
 
-  public var page: Int64 = 0
+  public var position: Int64 = 0
 
   public init() {}
 
   public mutating func _protoc_generated_decodeField(setter: inout ProtobufFieldDecoder, protoFieldNumber: Int) throws -> Bool {
     let handled: Bool
     switch protoFieldNumber {
-    case 1: handled = try setter.decodeRepeatedMessageField(fieldType: Page.self, value: &pages)
-    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &total)
-    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &page)
+    case 1: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &id)
+    case 2: handled = try setter.decodeSingularField(fieldType: ProtobufString.self, value: &notebookId)
+    case 3: handled = try setter.decodeSingularField(fieldType: ProtobufInt64.self, value: &position)
     default:
       handled = false
     }
@@ -628,21 +6696,21 @@ public struct PagesSet: ProtobufGeneratedMessage {
   }
 
   public func _protoc_generated_traverse(visitor: inout ProtobufVisitor) throws {
-    if !pages.isEmpty {
-      try visitor.visitRepeatedMessageField(value: pages, protoFieldNumber: 1, protoFieldName: "pages", jsonFieldName: "pages", swiftFieldName: "pages")
+    if id != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: id, protoFieldNumber: 1, protoFieldName: "id", jsonFieldName: "id", swiftFieldName: "id")
     }
-    if total != 0 {
-      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: total, protoFieldNumber: 2, protoFieldName: "total", jsonFieldName: "total", swiftFieldName: "total")
+    if notebookId != "" {
+      try visitor.visitSingularField(fieldType: ProtobufString.self, value: notebookId, protoFieldNumber: 2, protoFieldName: "notebook_id", jsonFieldName: "notebookId", swiftFieldName: "notebookId")
     }
-    if page != 0 {
-      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: page, protoFieldNumber: 3, protoFieldName: "page", jsonFieldName: "page", swiftFieldName: "page")
+    if position != 0 {
+      try visitor.visitSingularField(fieldType: ProtobufInt64.self, value: position, protoFieldNumber: 3, protoFieldName: "position", jsonFieldName: "position", swiftFieldName: "position")
     }
   }
 
-  public func _protoc_generated_isEqualTo(other: PagesSet) -> Bool {
-    if pages != other.pages {return false}
-    if total != other.total {return false}
-    if page != other.page {return false}
+  public func _protoc_generated_isEqualTo(other: PageMoveRequest) -> Bool {
+    if id != other.id {return false}
+    if notebookId != other.notebookId {return false}
+    if position != other.position {return false}
     return true
   }
 }
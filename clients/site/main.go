@@ -0,0 +1,410 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nathanborror/pages/pages"
+	"github.com/nathanborror/pages/render"
+	"github.com/nathanborror/pages/state"
+	"github.com/nathanborror/pages/state/memory"
+	"github.com/nathanborror/pages/state/sqlite"
+	"github.com/nathanborror/pages/wiki"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	addr      = flag.String("addr", "localhost:8080", "Server address to read pages from")
+	token     = flag.String("token", "", "Authentication token")
+	backend   = flag.String("state", "", "Read pages directly from this state backend (memory or sqlite) instead of the server")
+	out       = flag.String("out", "site", "Output directory")
+	templates = flag.String("templates", "", "Directory of templates overriding the built in index, page and list templates")
+	account   = flag.String("account", "", "Only publish pages by this account ID")
+	tagFilter = flag.String("tag", "", "Only publish pages with this tag")
+	full      = flag.Bool("full", false, "Rebuild every page, not just those modified since the last build")
+)
+
+// manifestFile records what the last build wrote so the next build can
+// skip pages that haven't been modified.
+const manifestFile = ".site.json"
+
+type manifest struct {
+	Templates string                   `json:"templates"`
+	Pages     map[string]manifestEntry `json:"pages"`
+}
+
+type manifestEntry struct {
+	Title    string `json:"title"`
+	Modified int64  `json:"modified"`
+}
+
+// Page data passed to templates.
+type sitePage struct {
+	ID       string
+	Title    string
+	Author   string
+	AuthorID string
+	Tags     []string
+	Content  template.HTML
+	Created  time.Time
+	Modified time.Time
+	Text     string
+}
+
+type listData struct {
+	Root  string
+	Title string
+	Pages []*sitePage
+}
+
+type pageData struct {
+	Root string
+	Page *sitePage
+}
+
+// Sources
+
+func loadFromServer(ctx context.Context) ([]*pages.Page, error) {
+	creds, err := credentials.NewClientTLSFromFile("dev.crt", "localhost")
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if *token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&auth{Token: *token}))
+	}
+	conn, err := grpc.Dial(*addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	set, err := pages.NewPagesClient(conn).PageList(ctx, &pages.PageListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return set.Pages, nil
+}
+
+func loadFromState(kind string) ([]*pages.Page, error) {
+	state.Register("memory", memory.New)
+	state.Register("sqlite", sqlite.New)
+	s := state.New(kind)
+	if s == nil {
+		return nil, fmt.Errorf("unknown state backend '%s'", kind)
+	}
+	return s.Pages()
+}
+
+// selectPages returns the public, published pages matching the account and
+// tag filters, newest first.
+func selectPages(recs []*pages.Page) []*sitePage {
+	var out []*sitePage
+	for _, rec := range recs {
+		if rec.Visibility != pages.Visibility_PUBLIC || rec.Status != pages.PageStatus_PUBLISHED {
+			continue
+		}
+		if *account != "" && rec.Account.Id != *account {
+			continue
+		}
+		tags := wiki.Tags(rec.Text)
+		if *tagFilter != "" && !contains(tags, strings.ToLower(*tagFilter)) {
+			continue
+		}
+		title := rec.Title
+		if title == "" {
+			title = "Untitled"
+		}
+		out = append(out, &sitePage{
+			ID:       rec.Id,
+			Title:    title,
+			Author:   rec.Account.Name,
+			AuthorID: rec.Account.Id,
+			Tags:     tags,
+			Created:  time.Unix(0, rec.Created).UTC(),
+			Modified: time.Unix(0, rec.Modified).UTC(),
+			Text:     rec.Text,
+		})
+	}
+	sort.Sort(byCreated(out))
+	return out
+}
+
+// Build
+
+func build(site []*sitePage, tmpl *template.Template, tmplHash string) error {
+	old := manifest{Pages: map[string]manifestEntry{}}
+	if data, err := ioutil.ReadFile(filepath.Join(*out, manifestFile)); err == nil {
+		if err := json.Unmarshal(data, &old); err != nil {
+			return fmt.Errorf("reading %s: %v", manifestFile, err)
+		}
+	}
+	next := manifest{Templates: tmplHash, Pages: map[string]manifestEntry{}}
+	for _, p := range site {
+		next.Pages[p.ID] = manifestEntry{Title: p.Title, Modified: p.Modified.UnixNano()}
+	}
+
+	// Wiki links render differently when pages are added, removed or
+	// renamed, and every page changes with the templates, so those changes
+	// rebuild everything.
+	rebuild := *full || old.Templates != tmplHash || len(old.Pages) != len(next.Pages)
+	for id, entry := range next.Pages {
+		if prev, ok := old.Pages[id]; !ok || prev.Title != entry.Title {
+			rebuild = true
+		}
+	}
+
+	for _, dir := range []string{"pages", "tags", "authors"} {
+		if err := os.MkdirAll(filepath.Join(*out, dir), 0755); err != nil {
+			return err
+		}
+	}
+
+	// Pages
+	byRef := make(map[string]*sitePage)
+	for _, p := range site {
+		byRef[p.ID] = p
+		if _, ok := byRef[strings.ToLower(p.Title)]; !ok {
+			byRef[strings.ToLower(p.Title)] = p
+		}
+	}
+	link := func(ref string) string {
+		if p, ok := byRef[ref]; ok {
+			return p.ID + ".html"
+		}
+		if p, ok := byRef[strings.ToLower(ref)]; ok {
+			return p.ID + ".html"
+		}
+		return ""
+	}
+	written := 0
+	for _, p := range site {
+		p.Content = template.HTML(render.HTML(p.Text, link))
+		if !rebuild && old.Pages[p.ID].Modified == p.Modified.UnixNano() {
+			continue
+		}
+		if err := write(tmpl, "page", filepath.Join("pages", p.ID+".html"), pageData{Root: "../", Page: p}); err != nil {
+			return err
+		}
+		written++
+	}
+	for id := range old.Pages {
+		if _, ok := next.Pages[id]; !ok {
+			os.Remove(filepath.Join(*out, "pages", id+".html"))
+		}
+	}
+
+	// Index, tag and author lists are cheap so they're always rewritten.
+	if err := write(tmpl, "list", "index.html", listData{Title: "Pages", Pages: site}); err != nil {
+		return err
+	}
+	tags := make(map[string][]*sitePage)
+	authors := make(map[string][]*sitePage)
+	for _, p := range site {
+		for _, tag := range p.Tags {
+			tags[tag] = append(tags[tag], p)
+		}
+		authors[p.AuthorID] = append(authors[p.AuthorID], p)
+	}
+	for _, dir := range []string{"tags", "authors"} {
+		if err := clean(filepath.Join(*out, dir)); err != nil {
+			return err
+		}
+	}
+	for tag, list := range tags {
+		if err := write(tmpl, "list", filepath.Join("tags", tag+".html"), listData{Root: "../", Title: "#" + tag, Pages: list}); err != nil {
+			return err
+		}
+	}
+	for id, list := range authors {
+		if err := write(tmpl, "list", filepath.Join("authors", id+".html"), listData{Root: "../", Title: list[0].Author, Pages: list}); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(next, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(*out, manifestFile), data, 0644); err != nil {
+		return err
+	}
+	log.Printf("site: wrote %d of %d pages, %d tags and %d authors to %s", written, len(site), len(tags), len(authors), *out)
+	return nil
+}
+
+func write(tmpl *template.Template, name, path string, data interface{}) error {
+	f, err := os.Create(filepath.Join(*out, path))
+	if err != nil {
+		return err
+	}
+	if err := tmpl.ExecuteTemplate(f, name, data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// clean removes the HTML files in dir.
+func clean(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadTemplates returns the built in templates, overridden by any in dir,
+// along with a hash of their source.
+func loadTemplates(dir string) (*template.Template, string, error) {
+	source := defaultTemplates
+	if dir != "" {
+		files, err := filepath.Glob(filepath.Join(dir, "*.html"))
+		if err != nil {
+			return nil, "", err
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, "", err
+			}
+			source += string(data)
+		}
+	}
+	funcs := template.FuncMap{
+		"date": func(t time.Time) string { return t.Format("January 2, 2006") },
+	}
+	tmpl, err := template.New("site").Funcs(funcs).Parse(source)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha1.Sum([]byte(source))
+	return tmpl, hex.EncodeToString(sum[:]), nil
+}
+
+// defaultTemplates define the 'page' and 'list' templates. Templates in the
+// -templates directory may redefine either, or the 'header' and 'footer'
+// they share.
+const defaultTemplates = `
+{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.}}</title>
+</head>
+<body>
+{{end}}
+
+{{define "footer"}}</body>
+</html>
+{{end}}
+
+{{define "page"}}{{template "header" .Page.Title}}<nav><a href="{{.Root}}index.html">Pages</a></nav>
+<article>
+{{.Page.Content}}
+<footer>
+<p>By <a href="{{.Root}}authors/{{.Page.AuthorID}}.html">{{.Page.Author}}</a> on {{date .Page.Created}}</p>
+{{if .Page.Tags}}<p>{{range .Page.Tags}}<a href="{{$.Root}}tags/{{.}}.html">#{{.}}</a> {{end}}</p>{{end}}
+</footer>
+</article>
+{{template "footer"}}{{end}}
+
+{{define "list"}}{{template "header" .Title}}{{if .Root}}<nav><a href="{{.Root}}index.html">Pages</a></nav>
+{{end}}<h1>{{.Title}}</h1>
+<ul>
+{{range .Pages}}<li><a href="{{$.Root}}pages/{{.ID}}.html">{{.Title}}</a> <small>{{date .Created}}</small></li>
+{{end}}</ul>
+{{template "footer"}}{{end}}
+`
+
+// Helpers
+
+type byCreated []*sitePage
+
+func (p byCreated) Len() int           { return len(p) }
+func (p byCreated) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byCreated) Less(i, j int) bool { return p[i].Created.After(p[j].Created) }
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Auth
+
+type auth struct {
+	Token string
+}
+
+func (a *auth) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"token": a.Token,
+	}, nil
+}
+
+func (a *auth) RequireTransportSecurity() bool {
+	return false
+}
+
+// Main
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, `Site publishes public pages as a static HTML website.
+
+Usage:
+
+  site [flags]
+
+Pages are read from the server, or from a state backend with -state. Only
+pages modified since the last build are rewritten unless -full is given.
+
+The flags are:
+
+`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	tmpl, tmplHash, err := loadTemplates(*templates)
+	if err != nil {
+		log.Fatalf("site: %v", err)
+	}
+
+	var recs []*pages.Page
+	if *backend != "" {
+		recs, err = loadFromState(*backend)
+	} else {
+		recs, err = loadFromServer(context.Background())
+	}
+	if err != nil {
+		log.Fatalf("site: %v", err)
+	}
+
+	if err := build(selectPages(recs), tmpl, tmplHash); err != nil {
+		log.Fatalf("site: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/nathanborror/pages/pages"
+)
+
+var word = regexp.MustCompile(`[\p{L}\p{N}']{2,32}|https?://[^\s/)\]]+`)
+
+// Classes the classifier is trained on.
+const (
+	spam = iota
+	ham
+)
+
+// Classifier is a naive Bayes spam classifier trained on pages admins have
+// reviewed. It quarantines text it thinks is spam with a probability of at
+// least its threshold. Until it has seen both spam and non-spam it allows
+// everything.
+type Classifier struct {
+	threshold float64
+
+	mu     sync.RWMutex
+	docs   [2]int
+	words  [2]map[string]int
+	totals [2]int
+	vocab  map[string]bool
+}
+
+// NewClassifier returns an untrained classifier that quarantines text with a
+// spam probability of at least threshold.
+func NewClassifier(threshold float64) *Classifier {
+	return &Classifier{
+		threshold: threshold,
+		words:     [2]map[string]int{make(map[string]int), make(map[string]int)},
+		vocab:     make(map[string]bool),
+	}
+}
+
+// Train adds text to the classifier as spam or not.
+func (c *Classifier) Train(text string, isSpam bool) {
+	class := ham
+	if isSpam {
+		class = spam
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.docs[class]++
+	for _, w := range tokens(text) {
+		c.words[class][w]++
+		c.totals[class]++
+		c.vocab[w] = true
+	}
+}
+
+// SpamProbability returns the probability that text is spam, or 0 if the
+// classifier can't tell yet.
+func (c *Classifier) SpamProbability(text string) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.docs[spam] == 0 || c.docs[ham] == 0 {
+		return 0
+	}
+
+	// Log probabilities with add-one smoothing keep words seen in only one
+	// class from deciding on their own and long texts from underflowing.
+	docs := float64(c.docs[spam] + c.docs[ham])
+	var score [2]float64
+	for class := range score {
+		score[class] = math.Log(float64(c.docs[class]) / docs)
+		denom := float64(c.totals[class] + len(c.vocab))
+		for _, w := range tokens(text) {
+			score[class] += math.Log(float64(c.words[class][w]+1) / denom)
+		}
+	}
+	return 1 / (1 + math.Exp(score[ham]-score[spam]))
+}
+
+func (c *Classifier) Check(text string) Decision {
+	if p := c.SpamProbability(text); p > 0 && p >= c.threshold {
+		return Decision{
+			Action:  pages.ModerationAction_QUARANTINE,
+			Checker: "bayes",
+			Reason:  fmt.Sprintf("Spam probability %.0f%%", p*100),
+		}
+	}
+	return Allow
+}
+
+// tokens returns the lower cased words and link hosts of text.
+func tokens(text string) []string {
+	out := word.FindAllString(strings.ToLower(text), -1)
+	for i, w := range out {
+		out[i] = strings.TrimPrefix(strings.TrimPrefix(w, "http://"), "https://")
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+// Package moderation decides whether page text may be published. Checkers
+// registered with a Pipeline each inspect the text and the strictest of their
+// decisions wins.
+package moderation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/nathanborror/pages/pages"
+)
+
+// Decision is a checker's verdict on page text. Checkers with no objection
+// return a Decision with the ALLOW action.
+type Decision struct {
+	Action  pages.ModerationAction
+	Checker string
+	Reason  string
+}
+
+// Allow is the decision of a checker with no objection.
+var Allow = Decision{Action: pages.ModerationAction_ALLOW}
+
+// Checker inspects page text.
+type Checker interface {
+	Check(text string) Decision
+}
+
+// Pipeline runs its registered checkers over page text.
+type Pipeline struct {
+	mu       sync.RWMutex
+	checkers []Checker
+}
+
+// Register adds a checker to the pipeline.
+func (p *Pipeline) Register(c Checker) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.checkers = append(p.checkers, c)
+}
+
+// Check returns the strictest decision of the registered checkers. Rejecting
+// is stricter than quarantining, and of equally strict decisions the one
+// from the checker registered first wins.
+func (p *Pipeline) Check(text string) Decision {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	out := Allow
+	for _, c := range p.checkers {
+		if d := c.Check(text); d.Action > out.Action {
+			out = d
+		}
+	}
+	return out
+}
+
+// Blocklist rejects text matching any of its patterns.
+type Blocklist struct {
+	patterns []*regexp.Regexp
+}
+
+// ParseBlocklist reads a blocklist of regular expressions, one per line.
+// Blank lines and lines starting with '#' are ignored. Patterns match case
+// insensitively.
+func ParseBlocklist(r io.Reader) (*Blocklist, error) {
+	b := &Blocklist{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		re, err := regexp.Compile("(?i)" + line)
+		if err != nil {
+			return nil, fmt.Errorf("moderation: blocklist line %d: %v", n, err)
+		}
+		b.patterns = append(b.patterns, re)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Blocklist) Check(text string) Decision {
+	for _, re := range b.patterns {
+		if re.MatchString(text) {
+			return Decision{
+				Action:  pages.ModerationAction_REJECT,
+				Checker: "blocklist",
+				Reason:  fmt.Sprintf("Matches blocked pattern '%s'", strings.TrimPrefix(re.String(), "(?i)")),
+			}
+		}
+	}
+	return Allow
+}
+
+var link = regexp.MustCompile(`(?i)\bhttps?://`)
+
+// LinkLimit quarantines text with more than Max links.
+type LinkLimit struct {
+	Max int
+}
+
+func (l LinkLimit) Check(text string) Decision {
+	if n := len(link.FindAllStringIndex(text, -1)); n > l.Max {
+		return Decision{
+			Action:  pages.ModerationAction_QUARANTINE,
+			Checker: "links",
+			Reason:  fmt.Sprintf("Has %d links, more than %d", n, l.Max),
+		}
+	}
+	return Allow
+}
@@ -14,12 +14,86 @@ It has these top-level messages:
 	Session
 	RegisterRequest
 	ConnectRequest
+	AccountExportRequest
+	ArchiveChunk
+	AccountImportResult
+	Quota
+	AccountPlanSetRequest
 	PageGetRequest
+	PageListRequest
 	PageCreateRequest
 	PageUpdateRequest
+	TextOp
+	PagePatchRequest
+	PageStatusUpdateRequest
 	PageDeleteRequest
+	PageLock
+	PageLockRequest
+	PageBatchCreateRequest
+	PageBatchUpdateRequest
+	PageBatchDeleteRequest
+	PageBatchItem
+	PageBatchResult
 	Page
 	PagesSet
+	PageShareRequest
+	PageUnshareRequest
+	PageCollaboratorsRequest
+	Collaborator
+	CollaboratorsSet
+	PageLinksRequest
+	PageForkRequest
+	PageForksRequest
+	PageLink
+	PageLinksSet
+	PageStatsRequest
+	PageViewBucket
+	PageViewCount
+	PageStatsResult
+	PageWatchRequest
+	PageEvent
+	ChangesSinceRequest
+	PageChange
+	ChangesSet
+	SyncChange
+	SyncRequest
+	SyncResult
+	SyncResponse
+	Attachment
+	AttachmentChunk
+	AttachmentDownloadRequest
+	Template
+	TemplateCreateRequest
+	TemplateDeleteRequest
+	TemplatesSet
+	CommentAnchor
+	Comment
+	CommentCreateRequest
+	CommentUpdateRequest
+	CommentDeleteRequest
+	CommentListRequest
+	CommentsSet
+	ModerationDecision
+	ModerationListRequest
+	ModerationReviewRequest
+	PageFlagRequest
+	ModerationDecisionsSet
+	Webhook
+	WebhookCreateRequest
+	WebhookDeleteRequest
+	WebhooksSet
+	WebhookDelivery
+	WebhookDeliveriesRequest
+	WebhookDeliveriesSet
+	Notebook
+	NotebookCreateRequest
+	NotebookListRequest
+	NotebookRenameRequest
+	NotebookMoveRequest
+	NotebookDeleteRequest
+	NotebookPagesRequest
+	NotebooksSet
+	PageMoveRequest
 */
 package pages
 
@@ -27,6 +101,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis/google/api"
+import google_protobuf "github.com/golang/protobuf/ptypes/field_mask"
 
 import (
 	context "golang.org/x/net/context"
@@ -44,6 +119,297 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ArchiveFormat is the container used for account archives. Archives hold a
+// manifest.json and a Markdown file with front-matter for every page.
+type ArchiveFormat int32
+
+const (
+	ArchiveFormat_ZIP ArchiveFormat = 0
+	ArchiveFormat_TAR ArchiveFormat = 1
+)
+
+var ArchiveFormat_name = map[int32]string{
+	0: "ZIP",
+	1: "TAR",
+}
+var ArchiveFormat_value = map[string]int32{
+	"ZIP": 0,
+	"TAR": 1,
+}
+
+func (x ArchiveFormat) String() string {
+	return proto.EnumName(ArchiveFormat_name, int32(x))
+}
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// Visibility controls who can read a page. Private pages are only readable
+// by their author, unlisted pages by anyone who knows the ID and public pages
+// are also included in page listings.
+type Visibility int32
+
+const (
+	Visibility_PRIVATE  Visibility = 0
+	Visibility_UNLISTED Visibility = 1
+	Visibility_PUBLIC   Visibility = 2
+)
+
+var Visibility_name = map[int32]string{
+	0: "PRIVATE",
+	1: "UNLISTED",
+	2: "PUBLIC",
+}
+var Visibility_value = map[string]int32{
+	"PRIVATE":  0,
+	"UNLISTED": 1,
+	"PUBLIC":   2,
+}
+
+func (x Visibility) String() string {
+	return proto.EnumName(Visibility_name, int32(x))
+}
+func (Visibility) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+// PageStatus is a page's publishing state. Drafts and scheduled pages are
+// only readable by their author until they're published. A page's publish at
+// time is when it was, or is scheduled to be, published. Quarantined pages
+// are held by moderation and only readable by their author until an admin
+// approves them.
+type PageStatus int32
+
+const (
+	PageStatus_PUBLISHED   PageStatus = 0
+	PageStatus_DRAFT       PageStatus = 1
+	PageStatus_SCHEDULED   PageStatus = 2
+	PageStatus_QUARANTINED PageStatus = 3
+)
+
+var PageStatus_name = map[int32]string{
+	0: "PUBLISHED",
+	1: "DRAFT",
+	2: "SCHEDULED",
+	3: "QUARANTINED",
+}
+var PageStatus_value = map[string]int32{
+	"PUBLISHED":   0,
+	"DRAFT":       1,
+	"SCHEDULED":   2,
+	"QUARANTINED": 3,
+}
+
+func (x PageStatus) String() string {
+	return proto.EnumName(PageStatus_name, int32(x))
+}
+func (PageStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+// Role is the level of access an account has to a page. Page authors are
+// always owners. Editors can change a page's text, but only owners can change
+// its visibility or share it.
+type Role int32
+
+const (
+	Role_NONE   Role = 0
+	Role_VIEWER Role = 1
+	Role_EDITOR Role = 2
+	Role_OWNER  Role = 3
+)
+
+var Role_name = map[int32]string{
+	0: "NONE",
+	1: "VIEWER",
+	2: "EDITOR",
+	3: "OWNER",
+}
+var Role_value = map[string]int32{
+	"NONE":   0,
+	"VIEWER": 1,
+	"EDITOR": 2,
+	"OWNER":  3,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+func (Role) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+// TextOpType is the kind of edit a text operation makes.
+type TextOpType int32
+
+const (
+	TextOpType_INSERT TextOpType = 0
+	TextOpType_DELETE TextOpType = 1
+)
+
+var TextOpType_name = map[int32]string{
+	0: "INSERT",
+	1: "DELETE",
+}
+var TextOpType_value = map[string]int32{
+	"INSERT": 0,
+	"DELETE": 1,
+}
+
+func (x TextOpType) String() string {
+	return proto.EnumName(TextOpType_name, int32(x))
+}
+func (TextOpType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+// BatchMode controls how a batch handles failing items. Atomic batches are
+// applied all-or-nothing, best effort batches apply every item that succeeds.
+type BatchMode int32
+
+const (
+	BatchMode_ATOMIC      BatchMode = 0
+	BatchMode_BEST_EFFORT BatchMode = 1
+)
+
+var BatchMode_name = map[int32]string{
+	0: "ATOMIC",
+	1: "BEST_EFFORT",
+}
+var BatchMode_value = map[string]int32{
+	"ATOMIC":      0,
+	"BEST_EFFORT": 1,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+func (BatchMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+// PageEventType describes the change a page event represents.
+type PageEventType int32
+
+const (
+	PageEventType_CREATED PageEventType = 0
+	PageEventType_UPDATED PageEventType = 1
+	PageEventType_DELETED PageEventType = 2
+)
+
+var PageEventType_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+var PageEventType_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x PageEventType) String() string {
+	return proto.EnumName(PageEventType_name, int32(x))
+}
+func (PageEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+// SyncOutcome is how a pushed change was resolved. Updates to pages changed
+// since their base version are merged with the server's text. If the edits
+// overlap, or the page was deleted or is locked by another account, the
+// update is saved as a new conflict copy page and the server's page is left
+// as is. Deletes of pages changed
+// since their base version are rejected.
+type SyncOutcome int32
+
+const (
+	SyncOutcome_APPLIED  SyncOutcome = 0
+	SyncOutcome_MERGED   SyncOutcome = 1
+	SyncOutcome_COPIED   SyncOutcome = 2
+	SyncOutcome_REJECTED SyncOutcome = 3
+)
+
+var SyncOutcome_name = map[int32]string{
+	0: "APPLIED",
+	1: "MERGED",
+	2: "COPIED",
+	3: "REJECTED",
+}
+var SyncOutcome_value = map[string]int32{
+	"APPLIED":  0,
+	"MERGED":   1,
+	"COPIED":   2,
+	"REJECTED": 3,
+}
+
+func (x SyncOutcome) String() string {
+	return proto.EnumName(SyncOutcome_name, int32(x))
+}
+func (SyncOutcome) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+// ModerationAction is what moderation does with a page write.
+type ModerationAction int32
+
+const (
+	ModerationAction_ALLOW      ModerationAction = 0
+	ModerationAction_QUARANTINE ModerationAction = 1
+	ModerationAction_REJECT     ModerationAction = 2
+)
+
+var ModerationAction_name = map[int32]string{
+	0: "ALLOW",
+	1: "QUARANTINE",
+	2: "REJECT",
+}
+var ModerationAction_value = map[string]int32{
+	"ALLOW":      0,
+	"QUARANTINE": 1,
+	"REJECT":     2,
+}
+
+func (x ModerationAction) String() string {
+	return proto.EnumName(ModerationAction_name, int32(x))
+}
+func (ModerationAction) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+// ReviewVerdict is an admin's judgement of a moderation decision.
+type ReviewVerdict int32
+
+const (
+	ReviewVerdict_PENDING  ReviewVerdict = 0
+	ReviewVerdict_SPAM     ReviewVerdict = 1
+	ReviewVerdict_NOT_SPAM ReviewVerdict = 2
+)
+
+var ReviewVerdict_name = map[int32]string{
+	0: "PENDING",
+	1: "SPAM",
+	2: "NOT_SPAM",
+}
+var ReviewVerdict_value = map[string]int32{
+	"PENDING":  0,
+	"SPAM":     1,
+	"NOT_SPAM": 2,
+}
+
+func (x ReviewVerdict) String() string {
+	return proto.EnumName(ReviewVerdict_name, int32(x))
+}
+func (ReviewVerdict) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+// DeliveryStatus is the state of a webhook delivery. Queued deliveries are
+// waiting for their next attempt.
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_QUEUED    DeliveryStatus = 0
+	DeliveryStatus_DELIVERED DeliveryStatus = 1
+	DeliveryStatus_FAILED    DeliveryStatus = 2
+)
+
+var DeliveryStatus_name = map[int32]string{
+	0: "QUEUED",
+	1: "DELIVERED",
+	2: "FAILED",
+}
+var DeliveryStatus_value = map[string]int32{
+	"QUEUED":    0,
+	"DELIVERED": 1,
+	"FAILED":    2,
+}
+
+func (x DeliveryStatus) String() string {
+	return proto.EnumName(DeliveryStatus_name, int32(x))
+}
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
 type Empty struct {
 }
 
@@ -52,12 +418,15 @@ func (m *Empty) String() string            { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()               {}
 func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+// Account plan names the quota plan the account is on. Accounts with no plan
+// get the server's default limits.
 type Account struct {
 	Id       string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Name     string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	Email    string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
 	Created  int64  `protobuf:"varint,5,opt,name=created" json:"created,omitempty"`
 	Modified int64  `protobuf:"varint,6,opt,name=modified" json:"modified,omitempty"`
+	Plan     string `protobuf:"bytes,7,opt,name=plan" json:"plan,omitempty"`
 }
 
 func (m *Account) Reset()                    { *m = Account{} }
@@ -103,33 +472,187 @@ func (m *ConnectRequest) String() string            { return proto.CompactTextSt
 func (*ConnectRequest) ProtoMessage()               {}
 func (*ConnectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
+type AccountExportRequest struct {
+	Format ArchiveFormat `protobuf:"varint,1,opt,name=format,enum=ArchiveFormat" json:"format,omitempty"`
+}
+
+func (m *AccountExportRequest) Reset()                    { *m = AccountExportRequest{} }
+func (m *AccountExportRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccountExportRequest) ProtoMessage()               {}
+func (*AccountExportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+// ArchiveChunk is a piece of an account archive being transferred.
+type ArchiveChunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ArchiveChunk) Reset()                    { *m = ArchiveChunk{} }
+func (m *ArchiveChunk) String() string            { return proto.CompactTextString(m) }
+func (*ArchiveChunk) ProtoMessage()               {}
+func (*ArchiveChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+// AccountImportResult counts the pages an import created, updated or left
+// unchanged. Errors describes pages that couldn't be imported.
+type AccountImportResult struct {
+	Created   int64    `protobuf:"varint,1,opt,name=created" json:"created,omitempty"`
+	Updated   int64    `protobuf:"varint,2,opt,name=updated" json:"updated,omitempty"`
+	Unchanged int64    `protobuf:"varint,3,opt,name=unchanged" json:"unchanged,omitempty"`
+	Errors    []string `protobuf:"bytes,4,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *AccountImportResult) Reset()                    { *m = AccountImportResult{} }
+func (m *AccountImportResult) String() string            { return proto.CompactTextString(m) }
+func (*AccountImportResult) ProtoMessage()               {}
+func (*AccountImportResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+// Quota is an account's usage and the limits of its plan. Pages counts the
+// pages the account owns and storage the bytes attached to them. Max text
+// bytes limits the size of a single page. A limit of zero means unlimited.
+type Quota struct {
+	Plan         string `protobuf:"bytes,1,opt,name=plan" json:"plan,omitempty"`
+	Pages        int64  `protobuf:"varint,2,opt,name=pages" json:"pages,omitempty"`
+	MaxPages     int64  `protobuf:"varint,3,opt,name=max_pages,json=maxPages" json:"max_pages,omitempty"`
+	Storage      int64  `protobuf:"varint,4,opt,name=storage" json:"storage,omitempty"`
+	MaxStorage   int64  `protobuf:"varint,5,opt,name=max_storage,json=maxStorage" json:"max_storage,omitempty"`
+	MaxTextBytes int64  `protobuf:"varint,6,opt,name=max_text_bytes,json=maxTextBytes" json:"max_text_bytes,omitempty"`
+}
+
+func (m *Quota) Reset()                    { *m = Quota{} }
+func (m *Quota) String() string            { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()               {}
+func (*Quota) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+type AccountPlanSetRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId" json:"account_id,omitempty"`
+	Plan      string `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+}
+
+func (m *AccountPlanSetRequest) Reset()                    { *m = AccountPlanSetRequest{} }
+func (m *AccountPlanSetRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccountPlanSetRequest) ProtoMessage()               {}
+func (*AccountPlanSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+// PageGetRequest gets a page. Giving as_of, a time in nanoseconds, gets the
+// page as it was at that time from its revisions, even if it has since been
+// deleted. Only its text, title, version and modified time are historical;
+// other fields are as last stored.
 type PageGetRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AsOf int64  `protobuf:"varint,2,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
 }
 
 func (m *PageGetRequest) Reset()                    { *m = PageGetRequest{} }
 func (m *PageGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*PageGetRequest) ProtoMessage()               {}
-func (*PageGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*PageGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+// PageListRequest lists pages. Giving as_of lists the pages as they were at
+// that time, as PageGetRequest does.
+type PageListRequest struct {
+	AsOf int64 `protobuf:"varint,1,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
+}
+
+func (m *PageListRequest) Reset()                    { *m = PageListRequest{} }
+func (m *PageListRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageListRequest) ProtoMessage()               {}
+func (*PageListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
+// PageCreateRequest creates a page from either text or one of the account's
+// templates. Template variables fill the template's custom fields.
 type PageCreateRequest struct {
-	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Text       string            `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Visibility Visibility        `protobuf:"varint,2,opt,name=visibility,enum=Visibility" json:"visibility,omitempty"`
+	TemplateId string            `protobuf:"bytes,3,opt,name=template_id,json=templateId" json:"template_id,omitempty"`
+	Variables  map[string]string `protobuf:"bytes,4,rep,name=variables" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Status     PageStatus        `protobuf:"varint,5,opt,name=status,enum=PageStatus" json:"status,omitempty"`
+	PublishAt  int64             `protobuf:"varint,6,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
 }
 
 func (m *PageCreateRequest) Reset()                    { *m = PageCreateRequest{} }
 func (m *PageCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PageCreateRequest) ProtoMessage()               {}
-func (*PageCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*PageCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *PageCreateRequest) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
 
+// PageUpdateRequest changes the fields of a page named in update_mask, which
+// may be "text" and "visibility". An empty mask changes every field, except
+// that visibility is left alone unless it's set; making a page private takes
+// a mask naming visibility. Over HTTP, a PATCH without a mask changes the
+// fields present in the body.
 type PageUpdateRequest struct {
-	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	Text string `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"`
+	Id         string                     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Text       string                     `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"`
+	Visibility Visibility                 `protobuf:"varint,3,opt,name=visibility,enum=Visibility" json:"visibility,omitempty"`
+	UpdateMask *google_protobuf.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask" json:"update_mask,omitempty"`
 }
 
 func (m *PageUpdateRequest) Reset()                    { *m = PageUpdateRequest{} }
 func (m *PageUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PageUpdateRequest) ProtoMessage()               {}
-func (*PageUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*PageUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *PageUpdateRequest) GetUpdateMask() *google_protobuf.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+// TextOp inserts text at, or deletes length characters from, an offset.
+// Offsets count Unicode code points and apply to the text as left by the
+// previous operation.
+type TextOp struct {
+	Type   TextOpType `protobuf:"varint,1,opt,name=type,enum=TextOpType" json:"type,omitempty"`
+	Offset int64      `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	Text   string     `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	Length int64      `protobuf:"varint,4,opt,name=length" json:"length,omitempty"`
+}
+
+func (m *TextOp) Reset()                    { *m = TextOp{} }
+func (m *TextOp) String() string            { return proto.CompactTextString(m) }
+func (*TextOp) ProtoMessage()               {}
+func (*TextOp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+// PagePatchRequest edits a page relative to the base version the client last
+// saw, using either a list of operations or unified diff hunks. Stale bases
+// are three-way merged with the current text.
+type PagePatchRequest struct {
+	Id          string    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	BaseVersion int64     `protobuf:"varint,2,opt,name=base_version,json=baseVersion" json:"base_version,omitempty"`
+	Ops         []*TextOp `protobuf:"bytes,3,rep,name=ops" json:"ops,omitempty"`
+	Diff        string    `protobuf:"bytes,4,opt,name=diff" json:"diff,omitempty"`
+}
+
+func (m *PagePatchRequest) Reset()                    { *m = PagePatchRequest{} }
+func (m *PagePatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*PagePatchRequest) ProtoMessage()               {}
+func (*PagePatchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *PagePatchRequest) GetOps() []*TextOp {
+	if m != nil {
+		return m.Ops
+	}
+	return nil
+}
+
+// PageStatusUpdateRequest changes a page's publishing state. Publish at is
+// required for scheduled pages.
+type PageStatusUpdateRequest struct {
+	Id        string     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Status    PageStatus `protobuf:"varint,2,opt,name=status,enum=PageStatus" json:"status,omitempty"`
+	PublishAt int64      `protobuf:"varint,3,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+}
+
+func (m *PageStatusUpdateRequest) Reset()                    { *m = PageStatusUpdateRequest{} }
+func (m *PageStatusUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageStatusUpdateRequest) ProtoMessage()               {}
+func (*PageStatusUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 type PageDeleteRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -138,355 +661,3292 @@ type PageDeleteRequest struct {
 func (m *PageDeleteRequest) Reset()                    { *m = PageDeleteRequest{} }
 func (m *PageDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*PageDeleteRequest) ProtoMessage()               {}
-func (*PageDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*PageDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
-type Page struct {
-	Id       string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+// PageLock is an edit lease on a page. Leases end at expires unless they're
+// renewed.
+type PageLock struct {
+	PageId   string   `protobuf:"bytes,1,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
 	Account  *Account `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
-	Text     string   `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
-	Created  int64    `protobuf:"varint,4,opt,name=created" json:"created,omitempty"`
-	Modified int64    `protobuf:"varint,5,opt,name=modified" json:"modified,omitempty"`
+	Acquired int64    `protobuf:"varint,3,opt,name=acquired" json:"acquired,omitempty"`
+	Expires  int64    `protobuf:"varint,4,opt,name=expires" json:"expires,omitempty"`
+}
+
+func (m *PageLock) Reset()                    { *m = PageLock{} }
+func (m *PageLock) String() string            { return proto.CompactTextString(m) }
+func (*PageLock) ProtoMessage()               {}
+func (*PageLock) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *PageLock) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// PageLockRequest asks for a lease lasting ttl seconds, five minutes if not
+// given. Ttl is ignored when releasing.
+type PageLockRequest struct {
+	Id  string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Ttl int64  `protobuf:"varint,2,opt,name=ttl" json:"ttl,omitempty"`
+}
+
+func (m *PageLockRequest) Reset()                    { *m = PageLockRequest{} }
+func (m *PageLockRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageLockRequest) ProtoMessage()               {}
+func (*PageLockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+type PageBatchCreateRequest struct {
+	Pages []*PageCreateRequest `protobuf:"bytes,1,rep,name=pages" json:"pages,omitempty"`
+	Mode  BatchMode            `protobuf:"varint,2,opt,name=mode,enum=BatchMode" json:"mode,omitempty"`
+}
+
+func (m *PageBatchCreateRequest) Reset()                    { *m = PageBatchCreateRequest{} }
+func (m *PageBatchCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageBatchCreateRequest) ProtoMessage()               {}
+func (*PageBatchCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *PageBatchCreateRequest) GetPages() []*PageCreateRequest {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+type PageBatchUpdateRequest struct {
+	Pages []*PageUpdateRequest `protobuf:"bytes,1,rep,name=pages" json:"pages,omitempty"`
+	Mode  BatchMode            `protobuf:"varint,2,opt,name=mode,enum=BatchMode" json:"mode,omitempty"`
+}
+
+func (m *PageBatchUpdateRequest) Reset()                    { *m = PageBatchUpdateRequest{} }
+func (m *PageBatchUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageBatchUpdateRequest) ProtoMessage()               {}
+func (*PageBatchUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *PageBatchUpdateRequest) GetPages() []*PageUpdateRequest {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+type PageBatchDeleteRequest struct {
+	Ids  []string  `protobuf:"bytes,1,rep,name=ids" json:"ids,omitempty"`
+	Mode BatchMode `protobuf:"varint,2,opt,name=mode,enum=BatchMode" json:"mode,omitempty"`
+}
+
+func (m *PageBatchDeleteRequest) Reset()                    { *m = PageBatchDeleteRequest{} }
+func (m *PageBatchDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageBatchDeleteRequest) ProtoMessage()               {}
+func (*PageBatchDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+// PageBatchItem is the outcome of one item in a batch. Code is a gRPC status
+// code and is zero when the item succeeded.
+type PageBatchItem struct {
+	Page  *Page  `protobuf:"bytes,1,opt,name=page" json:"page,omitempty"`
+	Code  int32  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *PageBatchItem) Reset()                    { *m = PageBatchItem{} }
+func (m *PageBatchItem) String() string            { return proto.CompactTextString(m) }
+func (*PageBatchItem) ProtoMessage()               {}
+func (*PageBatchItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *PageBatchItem) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// PageBatchResult lists item outcomes in request order. Committed is false
+// when an atomic batch was rolled back.
+type PageBatchResult struct {
+	Items     []*PageBatchItem `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	Committed bool             `protobuf:"varint,2,opt,name=committed" json:"committed,omitempty"`
+}
+
+func (m *PageBatchResult) Reset()                    { *m = PageBatchResult{} }
+func (m *PageBatchResult) String() string            { return proto.CompactTextString(m) }
+func (*PageBatchResult) ProtoMessage()               {}
+func (*PageBatchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *PageBatchResult) GetItems() []*PageBatchItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type Page struct {
+	Id          string        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Account     *Account      `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Text        string        `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	Created     int64         `protobuf:"varint,4,opt,name=created" json:"created,omitempty"`
+	Modified    int64         `protobuf:"varint,5,opt,name=modified" json:"modified,omitempty"`
+	Visibility  Visibility    `protobuf:"varint,6,opt,name=visibility,enum=Visibility" json:"visibility,omitempty"`
+	Version     int64         `protobuf:"varint,7,opt,name=version" json:"version,omitempty"`
+	Attachments []*Attachment `protobuf:"bytes,8,rep,name=attachments" json:"attachments,omitempty"`
+	Title       string        `protobuf:"bytes,9,opt,name=title" json:"title,omitempty"`
+	Status      PageStatus    `protobuf:"varint,10,opt,name=status,enum=PageStatus" json:"status,omitempty"`
+	PublishAt   int64         `protobuf:"varint,11,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+	Lock        *PageLock     `protobuf:"bytes,12,opt,name=lock" json:"lock,omitempty"`
+	ForkedFrom  string        `protobuf:"bytes,13,opt,name=forked_from,json=forkedFrom" json:"forked_from,omitempty"`
 }
 
 func (m *Page) Reset()                    { *m = Page{} }
 func (m *Page) String() string            { return proto.CompactTextString(m) }
 func (*Page) ProtoMessage()               {}
-func (*Page) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*Page) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *Page) GetAccount() *Account {
 	if m != nil {
 		return m.Account
 	}
-	return nil
+	return nil
+}
+
+func (m *Page) GetAttachments() []*Attachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *Page) GetLock() *PageLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+type PagesSet struct {
+	Pages []*Page `protobuf:"bytes,1,rep,name=pages" json:"pages,omitempty"`
+	Total int64   `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	Page  int64   `protobuf:"varint,3,opt,name=page" json:"page,omitempty"`
+}
+
+func (m *PagesSet) Reset()                    { *m = PagesSet{} }
+func (m *PagesSet) String() string            { return proto.CompactTextString(m) }
+func (*PagesSet) ProtoMessage()               {}
+func (*PagesSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *PagesSet) GetPages() []*Page {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+type PageShareRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	Role  Role   `protobuf:"varint,3,opt,name=role,enum=Role" json:"role,omitempty"`
+}
+
+func (m *PageShareRequest) Reset()                    { *m = PageShareRequest{} }
+func (m *PageShareRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageShareRequest) ProtoMessage()               {}
+func (*PageShareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+type PageUnshareRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+}
+
+func (m *PageUnshareRequest) Reset()                    { *m = PageUnshareRequest{} }
+func (m *PageUnshareRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageUnshareRequest) ProtoMessage()               {}
+func (*PageUnshareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+type PageCollaboratorsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *PageCollaboratorsRequest) Reset()                    { *m = PageCollaboratorsRequest{} }
+func (m *PageCollaboratorsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageCollaboratorsRequest) ProtoMessage()               {}
+func (*PageCollaboratorsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+type Collaborator struct {
+	Account *Account `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+	Role    Role     `protobuf:"varint,2,opt,name=role,enum=Role" json:"role,omitempty"`
+	Created int64    `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *Collaborator) Reset()                    { *m = Collaborator{} }
+func (m *Collaborator) String() string            { return proto.CompactTextString(m) }
+func (*Collaborator) ProtoMessage()               {}
+func (*Collaborator) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *Collaborator) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type CollaboratorsSet struct {
+	Collaborators []*Collaborator `protobuf:"bytes,1,rep,name=collaborators" json:"collaborators,omitempty"`
+}
+
+func (m *CollaboratorsSet) Reset()                    { *m = CollaboratorsSet{} }
+func (m *CollaboratorsSet) String() string            { return proto.CompactTextString(m) }
+func (*CollaboratorsSet) ProtoMessage()               {}
+func (*CollaboratorsSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *CollaboratorsSet) GetCollaborators() []*Collaborator {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
+type PageLinksRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *PageLinksRequest) Reset()                    { *m = PageLinksRequest{} }
+func (m *PageLinksRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageLinksRequest) ProtoMessage()               {}
+func (*PageLinksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+// PageForkRequest forks a page. Forks take the status and publish time
+// given, so a page can be forked as a draft.
+type PageForkRequest struct {
+	Id        string     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Status    PageStatus `protobuf:"varint,2,opt,name=status,enum=PageStatus" json:"status,omitempty"`
+	PublishAt int64      `protobuf:"varint,3,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+}
+
+func (m *PageForkRequest) Reset()                    { *m = PageForkRequest{} }
+func (m *PageForkRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageForkRequest) ProtoMessage()               {}
+func (*PageForkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+type PageForksRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *PageForksRequest) Reset()                    { *m = PageForksRequest{} }
+func (m *PageForksRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageForksRequest) ProtoMessage()               {}
+func (*PageForksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+// PageLink is a [[wiki link]] between pages. Ref is the link target as
+// written, either a page ID or a page title. Page is unset when the link
+// doesn't resolve to a page the viewer can read.
+type PageLink struct {
+	Ref  string `protobuf:"bytes,1,opt,name=ref" json:"ref,omitempty"`
+	Page *Page  `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (m *PageLink) Reset()                    { *m = PageLink{} }
+func (m *PageLink) String() string            { return proto.CompactTextString(m) }
+func (*PageLink) ProtoMessage()               {}
+func (*PageLink) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *PageLink) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type PageLinksSet struct {
+	Links []*PageLink `protobuf:"bytes,1,rep,name=links" json:"links,omitempty"`
+}
+
+func (m *PageLinksSet) Reset()                    { *m = PageLinksSet{} }
+func (m *PageLinksSet) String() string            { return proto.CompactTextString(m) }
+func (*PageLinksSet) ProtoMessage()               {}
+func (*PageLinksSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *PageLinksSet) GetLinks() []*PageLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+// PageStatsRequest asks for the views of a page, or of all the caller's pages
+// when id is empty, over the last days days.
+type PageStatsRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Days int64  `protobuf:"varint,2,opt,name=days" json:"days,omitempty"`
+}
+
+func (m *PageStatsRequest) Reset()                    { *m = PageStatsRequest{} }
+func (m *PageStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageStatsRequest) ProtoMessage()               {}
+func (*PageStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+// PageViewBucket counts the views in the UTC day starting at day.
+type PageViewBucket struct {
+	Day   int64 `protobuf:"varint,1,opt,name=day" json:"day,omitempty"`
+	Views int64 `protobuf:"varint,2,opt,name=views" json:"views,omitempty"`
+}
+
+func (m *PageViewBucket) Reset()                    { *m = PageViewBucket{} }
+func (m *PageViewBucket) String() string            { return proto.CompactTextString(m) }
+func (*PageViewBucket) ProtoMessage()               {}
+func (*PageViewBucket) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+type PageViewCount struct {
+	Page  *Page `protobuf:"bytes,1,opt,name=page" json:"page,omitempty"`
+	Views int64 `protobuf:"varint,2,opt,name=views" json:"views,omitempty"`
+}
+
+func (m *PageViewCount) Reset()                    { *m = PageViewCount{} }
+func (m *PageViewCount) String() string            { return proto.CompactTextString(m) }
+func (*PageViewCount) ProtoMessage()               {}
+func (*PageViewCount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *PageViewCount) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// PageStatsResult holds view counts for every day in the requested range,
+// oldest first. Top pages ranks the most viewed pages and is only set for
+// account stats. Each viewer counts at most once per page in any half hour.
+type PageStatsResult struct {
+	Days     []*PageViewBucket `protobuf:"bytes,1,rep,name=days" json:"days,omitempty"`
+	Total    int64             `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	TopPages []*PageViewCount  `protobuf:"bytes,3,rep,name=top_pages,json=topPages" json:"top_pages,omitempty"`
+}
+
+func (m *PageStatsResult) Reset()                    { *m = PageStatsResult{} }
+func (m *PageStatsResult) String() string            { return proto.CompactTextString(m) }
+func (*PageStatsResult) ProtoMessage()               {}
+func (*PageStatsResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *PageStatsResult) GetDays() []*PageViewBucket {
+	if m != nil {
+		return m.Days
+	}
+	return nil
+}
+
+func (m *PageStatsResult) GetTopPages() []*PageViewCount {
+	if m != nil {
+		return m.TopPages
+	}
+	return nil
+}
+
+type PageWatchRequest struct {
+	Id        string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId" json:"account_id,omitempty"`
+}
+
+func (m *PageWatchRequest) Reset()                    { *m = PageWatchRequest{} }
+func (m *PageWatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageWatchRequest) ProtoMessage()               {}
+func (*PageWatchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+type PageEvent struct {
+	Type    PageEventType `protobuf:"varint,1,opt,name=type,enum=PageEventType" json:"type,omitempty"`
+	Page    *Page         `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+	Created int64         `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *PageEvent) Reset()                    { *m = PageEvent{} }
+func (m *PageEvent) String() string            { return proto.CompactTextString(m) }
+func (*PageEvent) ProtoMessage()               {}
+func (*PageEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *PageEvent) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// ChangesSinceRequest asks for the changes after cursor, which is zero for
+// every change. Limit is the most changes returned.
+type ChangesSinceRequest struct {
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor" json:"cursor,omitempty"`
+	Limit  int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ChangesSinceRequest) Reset()                    { *m = ChangesSinceRequest{} }
+func (m *ChangesSinceRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangesSinceRequest) ProtoMessage()               {}
+func (*ChangesSinceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+// PageChange is the latest change to a page. Every page mutation advances a
+// page to the next sequence number. Deleted changes are tombstones that carry
+// only the page ID. Changes to pages the caller can't list are left out, as
+// are tombstones of pages they couldn't list when they were deleted.
+type PageChange struct {
+	Sequence int64         `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Type     PageEventType `protobuf:"varint,2,opt,name=type,enum=PageEventType" json:"type,omitempty"`
+	PageId   string        `protobuf:"bytes,3,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	Page     *Page         `protobuf:"bytes,4,opt,name=page" json:"page,omitempty"`
+	Created  int64         `protobuf:"varint,5,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *PageChange) Reset()                    { *m = PageChange{} }
+func (m *PageChange) String() string            { return proto.CompactTextString(m) }
+func (*PageChange) ProtoMessage()               {}
+func (*PageChange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *PageChange) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// ChangesSet holds changes in sequence order. Cursor is the sequence of the
+// last change, or the request's cursor if there were none, and more is set
+// when further changes are waiting.
+type ChangesSet struct {
+	Changes []*PageChange `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+	Cursor  int64         `protobuf:"varint,2,opt,name=cursor" json:"cursor,omitempty"`
+	More    bool          `protobuf:"varint,3,opt,name=more" json:"more,omitempty"`
+}
+
+func (m *ChangesSet) Reset()                    { *m = ChangesSet{} }
+func (m *ChangesSet) String() string            { return proto.CompactTextString(m) }
+func (*ChangesSet) ProtoMessage()               {}
+func (*ChangesSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *ChangesSet) GetChanges() []*PageChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// SyncChange is a change a client made to a page while offline. Ref is the
+// client's name for the change and is returned with its result. Created
+// changes make a new page from text and visibility. Updated changes replace
+// the text of the page as it was at base version, and deleted changes delete
+// the page if it's still at base version. Conflict copies of updates are
+// given the change's visibility.
+type SyncChange struct {
+	Ref         string        `protobuf:"bytes,1,opt,name=ref" json:"ref,omitempty"`
+	Type        PageEventType `protobuf:"varint,2,opt,name=type,enum=PageEventType" json:"type,omitempty"`
+	PageId      string        `protobuf:"bytes,3,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	BaseVersion int64         `protobuf:"varint,4,opt,name=base_version,json=baseVersion" json:"base_version,omitempty"`
+	Text        string        `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	Visibility  Visibility    `protobuf:"varint,6,opt,name=visibility,enum=Visibility" json:"visibility,omitempty"`
+}
+
+func (m *SyncChange) Reset()                    { *m = SyncChange{} }
+func (m *SyncChange) String() string            { return proto.CompactTextString(m) }
+func (*SyncChange) ProtoMessage()               {}
+func (*SyncChange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+// SyncRequest pushes changes to the server. The cursor of the first request
+// is where the server's changes start; it's ignored after that.
+type SyncRequest struct {
+	Cursor  int64         `protobuf:"varint,1,opt,name=cursor" json:"cursor,omitempty"`
+	Changes []*SyncChange `protobuf:"bytes,2,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *SyncRequest) Reset()                    { *m = SyncRequest{} }
+func (m *SyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*SyncRequest) ProtoMessage()               {}
+func (*SyncRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *SyncRequest) GetChanges() []*SyncChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// SyncResult is the outcome of a pushed change. Page is the page as left by
+// the change, which is the conflict copy for copied changes and the server's
+// page for rejected deletes. Error says why a change was rejected.
+type SyncResult struct {
+	Ref     string      `protobuf:"bytes,1,opt,name=ref" json:"ref,omitempty"`
+	Outcome SyncOutcome `protobuf:"varint,2,opt,name=outcome,enum=SyncOutcome" json:"outcome,omitempty"`
+	Page    *Page       `protobuf:"bytes,3,opt,name=page" json:"page,omitempty"`
+	Error   string      `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *SyncResult) Reset()                    { *m = SyncResult{} }
+func (m *SyncResult) String() string            { return proto.CompactTextString(m) }
+func (*SyncResult) ProtoMessage()               {}
+func (*SyncResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *SyncResult) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+// SyncResponse holds the results of a request's changes and the server's
+// changes after the cursor, in sequence order.
+type SyncResponse struct {
+	Results []*SyncResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	Changes []*PageChange `protobuf:"bytes,2,rep,name=changes" json:"changes,omitempty"`
+	Cursor  int64         `protobuf:"varint,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *SyncResponse) Reset()                    { *m = SyncResponse{} }
+func (m *SyncResponse) String() string            { return proto.CompactTextString(m) }
+func (*SyncResponse) ProtoMessage()               {}
+func (*SyncResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *SyncResponse) GetResults() []*SyncResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SyncResponse) GetChanges() []*PageChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type Attachment struct {
+	Id          string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	PageId      string `protobuf:"bytes,2,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,5,opt,name=size" json:"size,omitempty"`
+	Sha256      string `protobuf:"bytes,6,opt,name=sha256" json:"sha256,omitempty"`
+	Created     int64  `protobuf:"varint,7,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *Attachment) Reset()                    { *m = Attachment{} }
+func (m *Attachment) String() string            { return proto.CompactTextString(m) }
+func (*Attachment) ProtoMessage()               {}
+func (*Attachment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+// AttachmentChunk is a piece of an attachment being transferred. The first
+// chunk of a transfer also carries the attachment's page, name, content type
+// and size.
+type AttachmentChunk struct {
+	PageId      string `protobuf:"bytes,1,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	Data        []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AttachmentChunk) Reset()                    { *m = AttachmentChunk{} }
+func (m *AttachmentChunk) String() string            { return proto.CompactTextString(m) }
+func (*AttachmentChunk) ProtoMessage()               {}
+func (*AttachmentChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+type AttachmentDownloadRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *AttachmentDownloadRequest) Reset()                    { *m = AttachmentDownloadRequest{} }
+func (m *AttachmentDownloadRequest) String() string            { return proto.CompactTextString(m) }
+func (*AttachmentDownloadRequest) ProtoMessage()               {}
+func (*AttachmentDownloadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+// Template is boilerplate text for new pages. Text may use the {{date}},
+// {{time}} and {{author}} placeholders along with custom fields, which are
+// listed in fields.
+type Template struct {
+	Id       string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Account  *Account `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Name     string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Text     string   `protobuf:"bytes,4,opt,name=text" json:"text,omitempty"`
+	Fields   []string `protobuf:"bytes,5,rep,name=fields" json:"fields,omitempty"`
+	Created  int64    `protobuf:"varint,6,opt,name=created" json:"created,omitempty"`
+	Modified int64    `protobuf:"varint,7,opt,name=modified" json:"modified,omitempty"`
+}
+
+func (m *Template) Reset()                    { *m = Template{} }
+func (m *Template) String() string            { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()               {}
+func (*Template) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *Template) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type TemplateCreateRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"`
+}
+
+func (m *TemplateCreateRequest) Reset()                    { *m = TemplateCreateRequest{} }
+func (m *TemplateCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*TemplateCreateRequest) ProtoMessage()               {}
+func (*TemplateCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+type TemplateDeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *TemplateDeleteRequest) Reset()                    { *m = TemplateDeleteRequest{} }
+func (m *TemplateDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*TemplateDeleteRequest) ProtoMessage()               {}
+func (*TemplateDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+type TemplatesSet struct {
+	Templates []*Template `protobuf:"bytes,1,rep,name=templates" json:"templates,omitempty"`
+}
+
+func (m *TemplatesSet) Reset()                    { *m = TemplatesSet{} }
+func (m *TemplatesSet) String() string            { return proto.CompactTextString(m) }
+func (*TemplatesSet) ProtoMessage()               {}
+func (*TemplatesSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *TemplatesSet) GetTemplates() []*Template {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+// CommentAnchor pins a comment to the text between the start and end offsets
+// of a page version. Offsets count Unicode code points.
+type CommentAnchor struct {
+	Version int64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Start   int64 `protobuf:"varint,2,opt,name=start" json:"start,omitempty"`
+	End     int64 `protobuf:"varint,3,opt,name=end" json:"end,omitempty"`
+}
+
+func (m *CommentAnchor) Reset()                    { *m = CommentAnchor{} }
+func (m *CommentAnchor) String() string            { return proto.CompactTextString(m) }
+func (*CommentAnchor) ProtoMessage()               {}
+func (*CommentAnchor) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+// Comment is a remark on a page. Replies name the comment they answer as
+// their parent. Deleted comments that still have replies are kept without
+// their text so threads stay intact.
+type Comment struct {
+	Id       string         `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	PageId   string         `protobuf:"bytes,2,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	ParentId string         `protobuf:"bytes,3,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	Account  *Account       `protobuf:"bytes,4,opt,name=account" json:"account,omitempty"`
+	Text     string         `protobuf:"bytes,5,opt,name=text" json:"text,omitempty"`
+	Anchor   *CommentAnchor `protobuf:"bytes,6,opt,name=anchor" json:"anchor,omitempty"`
+	Created  int64          `protobuf:"varint,7,opt,name=created" json:"created,omitempty"`
+	Modified int64          `protobuf:"varint,8,opt,name=modified" json:"modified,omitempty"`
+	Deleted  bool           `protobuf:"varint,9,opt,name=deleted" json:"deleted,omitempty"`
+}
+
+func (m *Comment) Reset()                    { *m = Comment{} }
+func (m *Comment) String() string            { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()               {}
+func (*Comment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *Comment) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *Comment) GetAnchor() *CommentAnchor {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
+type CommentCreateRequest struct {
+	PageId   string         `protobuf:"bytes,1,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	ParentId string         `protobuf:"bytes,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	Text     string         `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	Anchor   *CommentAnchor `protobuf:"bytes,4,opt,name=anchor" json:"anchor,omitempty"`
+}
+
+func (m *CommentCreateRequest) Reset()                    { *m = CommentCreateRequest{} }
+func (m *CommentCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommentCreateRequest) ProtoMessage()               {}
+func (*CommentCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *CommentCreateRequest) GetAnchor() *CommentAnchor {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
+type CommentUpdateRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text" json:"text,omitempty"`
+}
+
+func (m *CommentUpdateRequest) Reset()                    { *m = CommentUpdateRequest{} }
+func (m *CommentUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommentUpdateRequest) ProtoMessage()               {}
+func (*CommentUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+type CommentDeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CommentDeleteRequest) Reset()                    { *m = CommentDeleteRequest{} }
+func (m *CommentDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommentDeleteRequest) ProtoMessage()               {}
+func (*CommentDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+type CommentListRequest struct {
+	PageId string `protobuf:"bytes,1,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+}
+
+func (m *CommentListRequest) Reset()                    { *m = CommentListRequest{} }
+func (m *CommentListRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommentListRequest) ProtoMessage()               {}
+func (*CommentListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+type CommentsSet struct {
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments" json:"comments,omitempty"`
+}
+
+func (m *CommentsSet) Reset()                    { *m = CommentsSet{} }
+func (m *CommentsSet) String() string            { return proto.CompactTextString(m) }
+func (*CommentsSet) ProtoMessage()               {}
+func (*CommentsSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *CommentsSet) GetComments() []*Comment {
+	if m != nil {
+		return m.Comments
+	}
+	return nil
+}
+
+// ModerationDecision records a page write moderation quarantined or
+// rejected, along with the checker that objected and the text written.
+// Rejected writes name the page they tried to update, if any. Status and
+// publish at are what a quarantined page is restored to when approved.
+type ModerationDecision struct {
+	Id         string           `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	PageId     string           `protobuf:"bytes,2,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	Account    *Account         `protobuf:"bytes,3,opt,name=account" json:"account,omitempty"`
+	Action     ModerationAction `protobuf:"varint,4,opt,name=action,enum=ModerationAction" json:"action,omitempty"`
+	Checker    string           `protobuf:"bytes,5,opt,name=checker" json:"checker,omitempty"`
+	Reason     string           `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+	Text       string           `protobuf:"bytes,7,opt,name=text" json:"text,omitempty"`
+	Created    int64            `protobuf:"varint,8,opt,name=created" json:"created,omitempty"`
+	Status     PageStatus       `protobuf:"varint,9,opt,name=status,enum=PageStatus" json:"status,omitempty"`
+	PublishAt  int64            `protobuf:"varint,10,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+	Verdict    ReviewVerdict    `protobuf:"varint,11,opt,name=verdict,enum=ReviewVerdict" json:"verdict,omitempty"`
+	ReviewerId string           `protobuf:"bytes,12,opt,name=reviewer_id,json=reviewerId" json:"reviewer_id,omitempty"`
+	Reviewed   int64            `protobuf:"varint,13,opt,name=reviewed" json:"reviewed,omitempty"`
+}
+
+func (m *ModerationDecision) Reset()                    { *m = ModerationDecision{} }
+func (m *ModerationDecision) String() string            { return proto.CompactTextString(m) }
+func (*ModerationDecision) ProtoMessage()               {}
+func (*ModerationDecision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *ModerationDecision) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// ModerationListRequest lists decisions, newest first. Page ID limits the
+// list to one page's decisions and pending to those not yet reviewed.
+type ModerationListRequest struct {
+	PageId  string `protobuf:"bytes,1,opt,name=page_id,json=pageId" json:"page_id,omitempty"`
+	Pending bool   `protobuf:"varint,2,opt,name=pending" json:"pending,omitempty"`
+}
+
+func (m *ModerationListRequest) Reset()                    { *m = ModerationListRequest{} }
+func (m *ModerationListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ModerationListRequest) ProtoMessage()               {}
+func (*ModerationListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+type ModerationReviewRequest struct {
+	Id      string        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Verdict ReviewVerdict `protobuf:"varint,2,opt,name=verdict,enum=ReviewVerdict" json:"verdict,omitempty"`
+}
+
+func (m *ModerationReviewRequest) Reset()                    { *m = ModerationReviewRequest{} }
+func (m *ModerationReviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*ModerationReviewRequest) ProtoMessage()               {}
+func (*ModerationReviewRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+type PageFlagRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *PageFlagRequest) Reset()                    { *m = PageFlagRequest{} }
+func (m *PageFlagRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageFlagRequest) ProtoMessage()               {}
+func (*PageFlagRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+type ModerationDecisionsSet struct {
+	Decisions []*ModerationDecision `protobuf:"bytes,1,rep,name=decisions" json:"decisions,omitempty"`
+}
+
+func (m *ModerationDecisionsSet) Reset()                    { *m = ModerationDecisionsSet{} }
+func (m *ModerationDecisionsSet) String() string            { return proto.CompactTextString(m) }
+func (*ModerationDecisionsSet) ProtoMessage()               {}
+func (*ModerationDecisionsSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *ModerationDecisionsSet) GetDecisions() []*ModerationDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+// Webhook subscribes a URL to events on the account's pages. A webhook with
+// no events receives every event. The secret signs deliveries and is only
+// returned when the webhook is created.
+type Webhook struct {
+	Id      string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Account *Account        `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Url     string          `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
+	Events  []PageEventType `protobuf:"varint,4,rep,packed,name=events,enum=PageEventType" json:"events,omitempty"`
+	Secret  string          `protobuf:"bytes,5,opt,name=secret" json:"secret,omitempty"`
+	Created int64           `protobuf:"varint,6,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (m *Webhook) String() string            { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *Webhook) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// WebhookCreateRequest subscribes a URL to events. A secret is generated if
+// none is given.
+type WebhookCreateRequest struct {
+	Url    string          `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Events []PageEventType `protobuf:"varint,2,rep,packed,name=events,enum=PageEventType" json:"events,omitempty"`
+	Secret string          `protobuf:"bytes,3,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *WebhookCreateRequest) Reset()                    { *m = WebhookCreateRequest{} }
+func (m *WebhookCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebhookCreateRequest) ProtoMessage()               {}
+func (*WebhookCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+type WebhookDeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *WebhookDeleteRequest) Reset()                    { *m = WebhookDeleteRequest{} }
+func (m *WebhookDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDeleteRequest) ProtoMessage()               {}
+func (*WebhookDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+type WebhooksSet struct {
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (m *WebhooksSet) Reset()                    { *m = WebhooksSet{} }
+func (m *WebhooksSet) String() string            { return proto.CompactTextString(m) }
+func (*WebhooksSet) ProtoMessage()               {}
+func (*WebhooksSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *WebhooksSet) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+// WebhookDelivery is a page event queued for a webhook. The payload is the
+// JSON encoded event, with the page as it was when the delivery was queued.
+// Response code and error describe the latest attempt.
+type WebhookDelivery struct {
+	Id           string         `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	WebhookId    string         `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId" json:"webhook_id,omitempty"`
+	Event        PageEventType  `protobuf:"varint,3,opt,name=event,enum=PageEventType" json:"event,omitempty"`
+	Payload      string         `protobuf:"bytes,4,opt,name=payload" json:"payload,omitempty"`
+	Status       DeliveryStatus `protobuf:"varint,5,opt,name=status,enum=DeliveryStatus" json:"status,omitempty"`
+	Attempts     int64          `protobuf:"varint,6,opt,name=attempts" json:"attempts,omitempty"`
+	NextAttempt  int64          `protobuf:"varint,7,opt,name=next_attempt,json=nextAttempt" json:"next_attempt,omitempty"`
+	ResponseCode int32          `protobuf:"varint,8,opt,name=response_code,json=responseCode" json:"response_code,omitempty"`
+	Error        string         `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	Created      int64          `protobuf:"varint,10,opt,name=created" json:"created,omitempty"`
+	Modified     int64          `protobuf:"varint,11,opt,name=modified" json:"modified,omitempty"`
+}
+
+func (m *WebhookDelivery) Reset()                    { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()               {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+type WebhookDeliveriesRequest struct {
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId" json:"webhook_id,omitempty"`
+	Limit     int64  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *WebhookDeliveriesRequest) Reset()                    { *m = WebhookDeliveriesRequest{} }
+func (m *WebhookDeliveriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDeliveriesRequest) ProtoMessage()               {}
+func (*WebhookDeliveriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+type WebhookDeliveriesSet struct {
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries" json:"deliveries,omitempty"`
+}
+
+func (m *WebhookDeliveriesSet) Reset()                    { *m = WebhookDeliveriesSet{} }
+func (m *WebhookDeliveriesSet) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDeliveriesSet) ProtoMessage()               {}
+func (*WebhookDeliveriesSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *WebhookDeliveriesSet) GetDeliveries() []*WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+// Notebook organizes an account's pages. Notebooks nest under a parent
+// notebook, or are top level if they have none, and are ordered by position
+// among their siblings. A page is in at most one notebook.
+type Notebook struct {
+	Id       string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Account  *Account `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Name     string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	ParentId string   `protobuf:"bytes,4,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	Position int64    `protobuf:"varint,5,opt,name=position" json:"position,omitempty"`
+	Created  int64    `protobuf:"varint,6,opt,name=created" json:"created,omitempty"`
+	Modified int64    `protobuf:"varint,7,opt,name=modified" json:"modified,omitempty"`
+}
+
+func (m *Notebook) Reset()                    { *m = Notebook{} }
+func (m *Notebook) String() string            { return proto.CompactTextString(m) }
+func (*Notebook) ProtoMessage()               {}
+func (*Notebook) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *Notebook) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+// NotebookCreateRequest creates a notebook last in its parent.
+type NotebookCreateRequest struct {
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+}
+
+func (m *NotebookCreateRequest) Reset()                    { *m = NotebookCreateRequest{} }
+func (m *NotebookCreateRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookCreateRequest) ProtoMessage()               {}
+func (*NotebookCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+type NotebookListRequest struct {
+	ParentId  string `protobuf:"bytes,1,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	Recursive bool   `protobuf:"varint,2,opt,name=recursive" json:"recursive,omitempty"`
+}
+
+func (m *NotebookListRequest) Reset()                    { *m = NotebookListRequest{} }
+func (m *NotebookListRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookListRequest) ProtoMessage()               {}
+func (*NotebookListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+type NotebookRenameRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *NotebookRenameRequest) Reset()                    { *m = NotebookRenameRequest{} }
+func (m *NotebookRenameRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookRenameRequest) ProtoMessage()               {}
+func (*NotebookRenameRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+// NotebookMoveRequest moves a notebook. Positions that are negative or past
+// the last sibling place it last.
+type NotebookMoveRequest struct {
+	Id       string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"`
+	Position int64  `protobuf:"varint,3,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *NotebookMoveRequest) Reset()                    { *m = NotebookMoveRequest{} }
+func (m *NotebookMoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookMoveRequest) ProtoMessage()               {}
+func (*NotebookMoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+type NotebookDeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *NotebookDeleteRequest) Reset()                    { *m = NotebookDeleteRequest{} }
+func (m *NotebookDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookDeleteRequest) ProtoMessage()               {}
+func (*NotebookDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+type NotebookPagesRequest struct {
+	Id        string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Recursive bool   `protobuf:"varint,2,opt,name=recursive" json:"recursive,omitempty"`
+}
+
+func (m *NotebookPagesRequest) Reset()                    { *m = NotebookPagesRequest{} }
+func (m *NotebookPagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotebookPagesRequest) ProtoMessage()               {}
+func (*NotebookPagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+type NotebooksSet struct {
+	Notebooks []*Notebook `protobuf:"bytes,1,rep,name=notebooks" json:"notebooks,omitempty"`
+}
+
+func (m *NotebooksSet) Reset()                    { *m = NotebooksSet{} }
+func (m *NotebooksSet) String() string            { return proto.CompactTextString(m) }
+func (*NotebooksSet) ProtoMessage()               {}
+func (*NotebooksSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *NotebooksSet) GetNotebooks() []*Notebook {
+	if m != nil {
+		return m.Notebooks
+	}
+	return nil
+}
+
+// PageMoveRequest files a page in a notebook. Positions that are negative or
+// past the notebook's last page place it last.
+type PageMoveRequest struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	NotebookId string `protobuf:"bytes,2,opt,name=notebook_id,json=notebookId" json:"notebook_id,omitempty"`
+	Position   int64  `protobuf:"varint,3,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *PageMoveRequest) Reset()                    { *m = PageMoveRequest{} }
+func (m *PageMoveRequest) String() string            { return proto.CompactTextString(m) }
+func (*PageMoveRequest) ProtoMessage()               {}
+func (*PageMoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "Empty")
+	proto.RegisterType((*Account)(nil), "Account")
+	proto.RegisterType((*Session)(nil), "Session")
+	proto.RegisterType((*RegisterRequest)(nil), "RegisterRequest")
+	proto.RegisterType((*ConnectRequest)(nil), "ConnectRequest")
+	proto.RegisterType((*AccountExportRequest)(nil), "AccountExportRequest")
+	proto.RegisterType((*ArchiveChunk)(nil), "ArchiveChunk")
+	proto.RegisterType((*AccountImportResult)(nil), "AccountImportResult")
+	proto.RegisterType((*Quota)(nil), "Quota")
+	proto.RegisterType((*AccountPlanSetRequest)(nil), "AccountPlanSetRequest")
+	proto.RegisterType((*PageGetRequest)(nil), "PageGetRequest")
+	proto.RegisterType((*PageListRequest)(nil), "PageListRequest")
+	proto.RegisterType((*PageCreateRequest)(nil), "PageCreateRequest")
+	proto.RegisterType((*PageUpdateRequest)(nil), "PageUpdateRequest")
+	proto.RegisterType((*TextOp)(nil), "TextOp")
+	proto.RegisterType((*PagePatchRequest)(nil), "PagePatchRequest")
+	proto.RegisterType((*PageStatusUpdateRequest)(nil), "PageStatusUpdateRequest")
+	proto.RegisterType((*PageDeleteRequest)(nil), "PageDeleteRequest")
+	proto.RegisterType((*PageLock)(nil), "PageLock")
+	proto.RegisterType((*PageLockRequest)(nil), "PageLockRequest")
+	proto.RegisterType((*PageBatchCreateRequest)(nil), "PageBatchCreateRequest")
+	proto.RegisterType((*PageBatchUpdateRequest)(nil), "PageBatchUpdateRequest")
+	proto.RegisterType((*PageBatchDeleteRequest)(nil), "PageBatchDeleteRequest")
+	proto.RegisterType((*PageBatchItem)(nil), "PageBatchItem")
+	proto.RegisterType((*PageBatchResult)(nil), "PageBatchResult")
+	proto.RegisterType((*Page)(nil), "Page")
+	proto.RegisterType((*PagesSet)(nil), "PagesSet")
+	proto.RegisterType((*PageShareRequest)(nil), "PageShareRequest")
+	proto.RegisterType((*PageUnshareRequest)(nil), "PageUnshareRequest")
+	proto.RegisterType((*PageCollaboratorsRequest)(nil), "PageCollaboratorsRequest")
+	proto.RegisterType((*Collaborator)(nil), "Collaborator")
+	proto.RegisterType((*CollaboratorsSet)(nil), "CollaboratorsSet")
+	proto.RegisterType((*PageLinksRequest)(nil), "PageLinksRequest")
+	proto.RegisterType((*PageForkRequest)(nil), "PageForkRequest")
+	proto.RegisterType((*PageForksRequest)(nil), "PageForksRequest")
+	proto.RegisterType((*PageLink)(nil), "PageLink")
+	proto.RegisterType((*PageLinksSet)(nil), "PageLinksSet")
+	proto.RegisterType((*PageStatsRequest)(nil), "PageStatsRequest")
+	proto.RegisterType((*PageViewBucket)(nil), "PageViewBucket")
+	proto.RegisterType((*PageViewCount)(nil), "PageViewCount")
+	proto.RegisterType((*PageStatsResult)(nil), "PageStatsResult")
+	proto.RegisterType((*PageWatchRequest)(nil), "PageWatchRequest")
+	proto.RegisterType((*PageEvent)(nil), "PageEvent")
+	proto.RegisterType((*ChangesSinceRequest)(nil), "ChangesSinceRequest")
+	proto.RegisterType((*PageChange)(nil), "PageChange")
+	proto.RegisterType((*ChangesSet)(nil), "ChangesSet")
+	proto.RegisterType((*SyncChange)(nil), "SyncChange")
+	proto.RegisterType((*SyncRequest)(nil), "SyncRequest")
+	proto.RegisterType((*SyncResult)(nil), "SyncResult")
+	proto.RegisterType((*SyncResponse)(nil), "SyncResponse")
+	proto.RegisterType((*Attachment)(nil), "Attachment")
+	proto.RegisterType((*AttachmentChunk)(nil), "AttachmentChunk")
+	proto.RegisterType((*AttachmentDownloadRequest)(nil), "AttachmentDownloadRequest")
+	proto.RegisterType((*Template)(nil), "Template")
+	proto.RegisterType((*TemplateCreateRequest)(nil), "TemplateCreateRequest")
+	proto.RegisterType((*TemplateDeleteRequest)(nil), "TemplateDeleteRequest")
+	proto.RegisterType((*TemplatesSet)(nil), "TemplatesSet")
+	proto.RegisterType((*CommentAnchor)(nil), "CommentAnchor")
+	proto.RegisterType((*Comment)(nil), "Comment")
+	proto.RegisterType((*CommentCreateRequest)(nil), "CommentCreateRequest")
+	proto.RegisterType((*CommentUpdateRequest)(nil), "CommentUpdateRequest")
+	proto.RegisterType((*CommentDeleteRequest)(nil), "CommentDeleteRequest")
+	proto.RegisterType((*CommentListRequest)(nil), "CommentListRequest")
+	proto.RegisterType((*CommentsSet)(nil), "CommentsSet")
+	proto.RegisterType((*ModerationDecision)(nil), "ModerationDecision")
+	proto.RegisterType((*ModerationListRequest)(nil), "ModerationListRequest")
+	proto.RegisterType((*ModerationReviewRequest)(nil), "ModerationReviewRequest")
+	proto.RegisterType((*PageFlagRequest)(nil), "PageFlagRequest")
+	proto.RegisterType((*ModerationDecisionsSet)(nil), "ModerationDecisionsSet")
+	proto.RegisterType((*Webhook)(nil), "Webhook")
+	proto.RegisterType((*WebhookCreateRequest)(nil), "WebhookCreateRequest")
+	proto.RegisterType((*WebhookDeleteRequest)(nil), "WebhookDeleteRequest")
+	proto.RegisterType((*WebhooksSet)(nil), "WebhooksSet")
+	proto.RegisterType((*WebhookDelivery)(nil), "WebhookDelivery")
+	proto.RegisterType((*WebhookDeliveriesRequest)(nil), "WebhookDeliveriesRequest")
+	proto.RegisterType((*WebhookDeliveriesSet)(nil), "WebhookDeliveriesSet")
+	proto.RegisterType((*Notebook)(nil), "Notebook")
+	proto.RegisterType((*NotebookCreateRequest)(nil), "NotebookCreateRequest")
+	proto.RegisterType((*NotebookListRequest)(nil), "NotebookListRequest")
+	proto.RegisterType((*NotebookRenameRequest)(nil), "NotebookRenameRequest")
+	proto.RegisterType((*NotebookMoveRequest)(nil), "NotebookMoveRequest")
+	proto.RegisterType((*NotebookDeleteRequest)(nil), "NotebookDeleteRequest")
+	proto.RegisterType((*NotebookPagesRequest)(nil), "NotebookPagesRequest")
+	proto.RegisterType((*NotebooksSet)(nil), "NotebooksSet")
+	proto.RegisterType((*PageMoveRequest)(nil), "PageMoveRequest")
+	proto.RegisterEnum("ArchiveFormat", ArchiveFormat_name, ArchiveFormat_value)
+	proto.RegisterEnum("Visibility", Visibility_name, Visibility_value)
+	proto.RegisterEnum("PageStatus", PageStatus_name, PageStatus_value)
+	proto.RegisterEnum("Role", Role_name, Role_value)
+	proto.RegisterEnum("TextOpType", TextOpType_name, TextOpType_value)
+	proto.RegisterEnum("BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterEnum("PageEventType", PageEventType_name, PageEventType_value)
+	proto.RegisterEnum("SyncOutcome", SyncOutcome_name, SyncOutcome_value)
+	proto.RegisterEnum("ModerationAction", ModerationAction_name, ModerationAction_value)
+	proto.RegisterEnum("ReviewVerdict", ReviewVerdict_name, ReviewVerdict_value)
+	proto.RegisterEnum("DeliveryStatus", DeliveryStatus_name, DeliveryStatus_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion3
+
+// Client API for Accounts service
+
+type AccountsClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Session, error)
+	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Session, error)
+	AccountExport(ctx context.Context, in *AccountExportRequest, opts ...grpc.CallOption) (Accounts_AccountExportClient, error)
+	AccountImport(ctx context.Context, opts ...grpc.CallOption) (Accounts_AccountImportClient, error)
+	QuotaGet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Quota, error)
+	AccountPlanSet(ctx context.Context, in *AccountPlanSetRequest, opts ...grpc.CallOption) (*Account, error)
+}
+
+type accountsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
+	return &accountsClient{cc}
+}
+
+func (c *accountsClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := grpc.Invoke(ctx, "/Accounts/Register", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := grpc.Invoke(ctx, "/Accounts/Connect", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) AccountExport(ctx context.Context, in *AccountExportRequest, opts ...grpc.CallOption) (Accounts_AccountExportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Accounts_serviceDesc.Streams[0], c.cc, "/Accounts/AccountExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsAccountExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Accounts_AccountExportClient interface {
+	Recv() (*ArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type accountsAccountExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsAccountExportClient) Recv() (*ArchiveChunk, error) {
+	m := new(ArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) AccountImport(ctx context.Context, opts ...grpc.CallOption) (Accounts_AccountImportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Accounts_serviceDesc.Streams[1], c.cc, "/Accounts/AccountImport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsAccountImportClient{stream}
+	return x, nil
+}
+
+type Accounts_AccountImportClient interface {
+	Send(*ArchiveChunk) error
+	CloseAndRecv() (*AccountImportResult, error)
+	grpc.ClientStream
+}
+
+type accountsAccountImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsAccountImportClient) Send(m *ArchiveChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *accountsAccountImportClient) CloseAndRecv() (*AccountImportResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AccountImportResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) QuotaGet(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Quota, error) {
+	out := new(Quota)
+	err := grpc.Invoke(ctx, "/Accounts/QuotaGet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) AccountPlanSet(ctx context.Context, in *AccountPlanSetRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/Accounts/AccountPlanSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Accounts service
+
+type AccountsServer interface {
+	Register(context.Context, *RegisterRequest) (*Session, error)
+	Connect(context.Context, *ConnectRequest) (*Session, error)
+	AccountExport(*AccountExportRequest, Accounts_AccountExportServer) error
+	AccountImport(Accounts_AccountImportServer) error
+	QuotaGet(context.Context, *Empty) (*Quota, error)
+	AccountPlanSet(context.Context, *AccountPlanSetRequest) (*Account, error)
+}
+
+func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
+	s.RegisterService(&_Accounts_serviceDesc, srv)
+}
+
+func _Accounts_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Accounts/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Accounts/Connect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).Connect(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_AccountExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServer).AccountExport(m, &accountsAccountExportServer{stream})
+}
+
+type Accounts_AccountExportServer interface {
+	Send(*ArchiveChunk) error
+	grpc.ServerStream
+}
+
+type accountsAccountExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsAccountExportServer) Send(m *ArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Accounts_AccountImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AccountsServer).AccountImport(&accountsAccountImportServer{stream})
+}
+
+type Accounts_AccountImportServer interface {
+	SendAndClose(*AccountImportResult) error
+	Recv() (*ArchiveChunk, error)
+	grpc.ServerStream
+}
+
+type accountsAccountImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsAccountImportServer) SendAndClose(m *AccountImportResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *accountsAccountImportServer) Recv() (*ArchiveChunk, error) {
+	m := new(ArchiveChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Accounts_QuotaGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).QuotaGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Accounts/QuotaGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).QuotaGet(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_AccountPlanSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountPlanSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).AccountPlanSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Accounts/AccountPlanSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).AccountPlanSet(ctx, req.(*AccountPlanSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Accounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Accounts",
+	HandlerType: (*AccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _Accounts_Register_Handler,
+		},
+		{
+			MethodName: "Connect",
+			Handler:    _Accounts_Connect_Handler,
+		},
+		{
+			MethodName: "QuotaGet",
+			Handler:    _Accounts_QuotaGet_Handler,
+		},
+		{
+			MethodName: "AccountPlanSet",
+			Handler:    _Accounts_AccountPlanSet_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AccountExport",
+			Handler:       _Accounts_AccountExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AccountImport",
+			Handler:       _Accounts_AccountImport_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: fileDescriptor0,
+}
+
+// Client API for Pages service
+
+type PagesClient interface {
+	PageCreate(ctx context.Context, in *PageCreateRequest, opts ...grpc.CallOption) (*Page, error)
+	PageUpdate(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*Page, error)
+	PagePatch(ctx context.Context, in *PagePatchRequest, opts ...grpc.CallOption) (*Page, error)
+	PageStatusUpdate(ctx context.Context, in *PageStatusUpdateRequest, opts ...grpc.CallOption) (*Page, error)
+	PageDelete(ctx context.Context, in *PageDeleteRequest, opts ...grpc.CallOption) (*Page, error)
+	PageLockAcquire(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*PageLock, error)
+	PageLockRenew(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*PageLock, error)
+	PageLockRelease(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*Page, error)
+	PageBatchCreate(ctx context.Context, in *PageBatchCreateRequest, opts ...grpc.CallOption) (*PageBatchResult, error)
+	PageBatchUpdate(ctx context.Context, in *PageBatchUpdateRequest, opts ...grpc.CallOption) (*PageBatchResult, error)
+	PageBatchDelete(ctx context.Context, in *PageBatchDeleteRequest, opts ...grpc.CallOption) (*PageBatchResult, error)
+	PageGet(ctx context.Context, in *PageGetRequest, opts ...grpc.CallOption) (*Page, error)
+	PageList(ctx context.Context, in *PageListRequest, opts ...grpc.CallOption) (*PagesSet, error)
+	PageShare(ctx context.Context, in *PageShareRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error)
+	PageUnshare(ctx context.Context, in *PageUnshareRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error)
+	PageCollaborators(ctx context.Context, in *PageCollaboratorsRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error)
+	PageBacklinks(ctx context.Context, in *PageLinksRequest, opts ...grpc.CallOption) (*PageLinksSet, error)
+	PageOutlinks(ctx context.Context, in *PageLinksRequest, opts ...grpc.CallOption) (*PageLinksSet, error)
+	PageFork(ctx context.Context, in *PageForkRequest, opts ...grpc.CallOption) (*Page, error)
+	PageForks(ctx context.Context, in *PageForksRequest, opts ...grpc.CallOption) (*PagesSet, error)
+	PageStats(ctx context.Context, in *PageStatsRequest, opts ...grpc.CallOption) (*PageStatsResult, error)
+	PageWatch(ctx context.Context, in *PageWatchRequest, opts ...grpc.CallOption) (Pages_PageWatchClient, error)
+	ChangesSince(ctx context.Context, in *ChangesSinceRequest, opts ...grpc.CallOption) (*ChangesSet, error)
+	Sync(ctx context.Context, opts ...grpc.CallOption) (Pages_SyncClient, error)
+	AttachmentUpload(ctx context.Context, opts ...grpc.CallOption) (Pages_AttachmentUploadClient, error)
+	AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Pages_AttachmentDownloadClient, error)
+	TemplateCreate(ctx context.Context, in *TemplateCreateRequest, opts ...grpc.CallOption) (*Template, error)
+	TemplateList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TemplatesSet, error)
+	TemplateDelete(ctx context.Context, in *TemplateDeleteRequest, opts ...grpc.CallOption) (*Template, error)
+}
+
+type pagesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPagesClient(cc *grpc.ClientConn) PagesClient {
+	return &pagesClient{cc}
+}
+
+func (c *pagesClient) PageCreate(ctx context.Context, in *PageCreateRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageUpdate(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PagePatch(ctx context.Context, in *PagePatchRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PagePatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageStatusUpdate(ctx context.Context, in *PageStatusUpdateRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageStatusUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageDelete(ctx context.Context, in *PageDeleteRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageLockAcquire(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*PageLock, error) {
+	out := new(PageLock)
+	err := grpc.Invoke(ctx, "/Pages/PageLockAcquire", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageLockRenew(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*PageLock, error) {
+	out := new(PageLock)
+	err := grpc.Invoke(ctx, "/Pages/PageLockRenew", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageLockRelease(ctx context.Context, in *PageLockRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageLockRelease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageBatchCreate(ctx context.Context, in *PageBatchCreateRequest, opts ...grpc.CallOption) (*PageBatchResult, error) {
+	out := new(PageBatchResult)
+	err := grpc.Invoke(ctx, "/Pages/PageBatchCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageBatchUpdate(ctx context.Context, in *PageBatchUpdateRequest, opts ...grpc.CallOption) (*PageBatchResult, error) {
+	out := new(PageBatchResult)
+	err := grpc.Invoke(ctx, "/Pages/PageBatchUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageBatchDelete(ctx context.Context, in *PageBatchDeleteRequest, opts ...grpc.CallOption) (*PageBatchResult, error) {
+	out := new(PageBatchResult)
+	err := grpc.Invoke(ctx, "/Pages/PageBatchDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageGet(ctx context.Context, in *PageGetRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageGet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageList(ctx context.Context, in *PageListRequest, opts ...grpc.CallOption) (*PagesSet, error) {
+	out := new(PagesSet)
+	err := grpc.Invoke(ctx, "/Pages/PageList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageShare(ctx context.Context, in *PageShareRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error) {
+	out := new(CollaboratorsSet)
+	err := grpc.Invoke(ctx, "/Pages/PageShare", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageUnshare(ctx context.Context, in *PageUnshareRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error) {
+	out := new(CollaboratorsSet)
+	err := grpc.Invoke(ctx, "/Pages/PageUnshare", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageCollaborators(ctx context.Context, in *PageCollaboratorsRequest, opts ...grpc.CallOption) (*CollaboratorsSet, error) {
+	out := new(CollaboratorsSet)
+	err := grpc.Invoke(ctx, "/Pages/PageCollaborators", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageBacklinks(ctx context.Context, in *PageLinksRequest, opts ...grpc.CallOption) (*PageLinksSet, error) {
+	out := new(PageLinksSet)
+	err := grpc.Invoke(ctx, "/Pages/PageBacklinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageOutlinks(ctx context.Context, in *PageLinksRequest, opts ...grpc.CallOption) (*PageLinksSet, error) {
+	out := new(PageLinksSet)
+	err := grpc.Invoke(ctx, "/Pages/PageOutlinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageFork(ctx context.Context, in *PageForkRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Pages/PageFork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageForks(ctx context.Context, in *PageForksRequest, opts ...grpc.CallOption) (*PagesSet, error) {
+	out := new(PagesSet)
+	err := grpc.Invoke(ctx, "/Pages/PageForks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageStats(ctx context.Context, in *PageStatsRequest, opts ...grpc.CallOption) (*PageStatsResult, error) {
+	out := new(PageStatsResult)
+	err := grpc.Invoke(ctx, "/Pages/PageStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) PageWatch(ctx context.Context, in *PageWatchRequest, opts ...grpc.CallOption) (Pages_PageWatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Pages_serviceDesc.Streams[0], c.cc, "/Pages/PageWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pagesPageWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pages_PageWatchClient interface {
+	Recv() (*PageEvent, error)
+	grpc.ClientStream
+}
+
+type pagesPageWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *pagesPageWatchClient) Recv() (*PageEvent, error) {
+	m := new(PageEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pagesClient) ChangesSince(ctx context.Context, in *ChangesSinceRequest, opts ...grpc.CallOption) (*ChangesSet, error) {
+	out := new(ChangesSet)
+	err := grpc.Invoke(ctx, "/Pages/ChangesSince", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) Sync(ctx context.Context, opts ...grpc.CallOption) (Pages_SyncClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Pages_serviceDesc.Streams[1], c.cc, "/Pages/Sync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pagesSyncClient{stream}
+	return x, nil
+}
+
+type Pages_SyncClient interface {
+	Send(*SyncRequest) error
+	Recv() (*SyncResponse, error)
+	grpc.ClientStream
+}
+
+type pagesSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *pagesSyncClient) Send(m *SyncRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pagesSyncClient) Recv() (*SyncResponse, error) {
+	m := new(SyncResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pagesClient) AttachmentUpload(ctx context.Context, opts ...grpc.CallOption) (Pages_AttachmentUploadClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Pages_serviceDesc.Streams[2], c.cc, "/Pages/AttachmentUpload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pagesAttachmentUploadClient{stream}
+	return x, nil
+}
+
+type Pages_AttachmentUploadClient interface {
+	Send(*AttachmentChunk) error
+	CloseAndRecv() (*Attachment, error)
+	grpc.ClientStream
+}
+
+type pagesAttachmentUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *pagesAttachmentUploadClient) Send(m *AttachmentChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pagesAttachmentUploadClient) CloseAndRecv() (*Attachment, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Attachment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pagesClient) AttachmentDownload(ctx context.Context, in *AttachmentDownloadRequest, opts ...grpc.CallOption) (Pages_AttachmentDownloadClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Pages_serviceDesc.Streams[3], c.cc, "/Pages/AttachmentDownload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pagesAttachmentDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pages_AttachmentDownloadClient interface {
+	Recv() (*AttachmentChunk, error)
+	grpc.ClientStream
+}
+
+type pagesAttachmentDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *pagesAttachmentDownloadClient) Recv() (*AttachmentChunk, error) {
+	m := new(AttachmentChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pagesClient) TemplateCreate(ctx context.Context, in *TemplateCreateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := grpc.Invoke(ctx, "/Pages/TemplateCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) TemplateList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TemplatesSet, error) {
+	out := new(TemplatesSet)
+	err := grpc.Invoke(ctx, "/Pages/TemplateList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pagesClient) TemplateDelete(ctx context.Context, in *TemplateDeleteRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := grpc.Invoke(ctx, "/Pages/TemplateDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Pages service
+
+type PagesServer interface {
+	PageCreate(context.Context, *PageCreateRequest) (*Page, error)
+	PageUpdate(context.Context, *PageUpdateRequest) (*Page, error)
+	PagePatch(context.Context, *PagePatchRequest) (*Page, error)
+	PageStatusUpdate(context.Context, *PageStatusUpdateRequest) (*Page, error)
+	PageDelete(context.Context, *PageDeleteRequest) (*Page, error)
+	PageLockAcquire(context.Context, *PageLockRequest) (*PageLock, error)
+	PageLockRenew(context.Context, *PageLockRequest) (*PageLock, error)
+	PageLockRelease(context.Context, *PageLockRequest) (*Page, error)
+	PageBatchCreate(context.Context, *PageBatchCreateRequest) (*PageBatchResult, error)
+	PageBatchUpdate(context.Context, *PageBatchUpdateRequest) (*PageBatchResult, error)
+	PageBatchDelete(context.Context, *PageBatchDeleteRequest) (*PageBatchResult, error)
+	PageGet(context.Context, *PageGetRequest) (*Page, error)
+	PageList(context.Context, *PageListRequest) (*PagesSet, error)
+	PageShare(context.Context, *PageShareRequest) (*CollaboratorsSet, error)
+	PageUnshare(context.Context, *PageUnshareRequest) (*CollaboratorsSet, error)
+	PageCollaborators(context.Context, *PageCollaboratorsRequest) (*CollaboratorsSet, error)
+	PageBacklinks(context.Context, *PageLinksRequest) (*PageLinksSet, error)
+	PageOutlinks(context.Context, *PageLinksRequest) (*PageLinksSet, error)
+	PageFork(context.Context, *PageForkRequest) (*Page, error)
+	PageForks(context.Context, *PageForksRequest) (*PagesSet, error)
+	PageStats(context.Context, *PageStatsRequest) (*PageStatsResult, error)
+	PageWatch(*PageWatchRequest, Pages_PageWatchServer) error
+	ChangesSince(context.Context, *ChangesSinceRequest) (*ChangesSet, error)
+	Sync(Pages_SyncServer) error
+	AttachmentUpload(Pages_AttachmentUploadServer) error
+	AttachmentDownload(*AttachmentDownloadRequest, Pages_AttachmentDownloadServer) error
+	TemplateCreate(context.Context, *TemplateCreateRequest) (*Template, error)
+	TemplateList(context.Context, *Empty) (*TemplatesSet, error)
+	TemplateDelete(context.Context, *TemplateDeleteRequest) (*Template, error)
+}
+
+func RegisterPagesServer(s *grpc.Server, srv PagesServer) {
+	s.RegisterService(&_Pages_serviceDesc, srv)
+}
+
+func _Pages_PageCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageCreate(ctx, req.(*PageCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageUpdate(ctx, req.(*PageUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PagePatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PagePatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PagePatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PagePatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PagePatch(ctx, req.(*PagePatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageStatusUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageStatusUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageStatusUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageStatusUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageStatusUpdate(ctx, req.(*PageStatusUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageDelete(ctx, req.(*PageDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageLockAcquire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageLockAcquire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageLockAcquire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageLockAcquire(ctx, req.(*PageLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageLockRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageLockRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageLockRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageLockRenew(ctx, req.(*PageLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageLockRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageLockRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageLockRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageLockRelease(ctx, req.(*PageLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageBatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageBatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageBatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageBatchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageBatchCreate(ctx, req.(*PageBatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageBatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageBatchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageBatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageBatchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageBatchUpdate(ctx, req.(*PageBatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageBatchDelete(ctx, req.(*PageBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageGet(ctx, req.(*PageGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageList(ctx, req.(*PageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageShare(ctx, req.(*PageShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageUnshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageUnshareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageUnshare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageUnshare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageUnshare(ctx, req.(*PageUnshareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageCollaborators(ctx, req.(*PageCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageBacklinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageBacklinks(ctx, req.(*PageLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageOutlinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageOutlinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageOutlinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageOutlinks(ctx, req.(*PageLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageFork(ctx, req.(*PageForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageForks(ctx, req.(*PageForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).PageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/PageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).PageStats(ctx, req.(*PageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_PageWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PageWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PagesServer).PageWatch(m, &pagesPageWatchServer{stream})
+}
+
+type Pages_PageWatchServer interface {
+	Send(*PageEvent) error
+	grpc.ServerStream
+}
+
+type pagesPageWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *pagesPageWatchServer) Send(m *PageEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pages_ChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangesSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).ChangesSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/ChangesSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).ChangesSince(ctx, req.(*ChangesSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PagesServer).Sync(&pagesSyncServer{stream})
+}
+
+type Pages_SyncServer interface {
+	Send(*SyncResponse) error
+	Recv() (*SyncRequest, error)
+	grpc.ServerStream
+}
+
+type pagesSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *pagesSyncServer) Send(m *SyncResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pagesSyncServer) Recv() (*SyncRequest, error) {
+	m := new(SyncRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Pages_AttachmentUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PagesServer).AttachmentUpload(&pagesAttachmentUploadServer{stream})
+}
+
+type Pages_AttachmentUploadServer interface {
+	SendAndClose(*Attachment) error
+	Recv() (*AttachmentChunk, error)
+	grpc.ServerStream
+}
+
+type pagesAttachmentUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *pagesAttachmentUploadServer) SendAndClose(m *Attachment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pagesAttachmentUploadServer) Recv() (*AttachmentChunk, error) {
+	m := new(AttachmentChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Pages_AttachmentDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttachmentDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PagesServer).AttachmentDownload(m, &pagesAttachmentDownloadServer{stream})
+}
+
+type Pages_AttachmentDownloadServer interface {
+	Send(*AttachmentChunk) error
+	grpc.ServerStream
+}
+
+type pagesAttachmentDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *pagesAttachmentDownloadServer) Send(m *AttachmentChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pages_TemplateCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).TemplateCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/TemplateCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).TemplateCreate(ctx, req.(*TemplateCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_TemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).TemplateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/TemplateList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).TemplateList(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pages_TemplateDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TemplateDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PagesServer).TemplateDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Pages/TemplateDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PagesServer).TemplateDelete(ctx, req.(*TemplateDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Pages_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Pages",
+	HandlerType: (*PagesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PageCreate",
+			Handler:    _Pages_PageCreate_Handler,
+		},
+		{
+			MethodName: "PageUpdate",
+			Handler:    _Pages_PageUpdate_Handler,
+		},
+		{
+			MethodName: "PagePatch",
+			Handler:    _Pages_PagePatch_Handler,
+		},
+		{
+			MethodName: "PageStatusUpdate",
+			Handler:    _Pages_PageStatusUpdate_Handler,
+		},
+		{
+			MethodName: "PageDelete",
+			Handler:    _Pages_PageDelete_Handler,
+		},
+		{
+			MethodName: "PageLockAcquire",
+			Handler:    _Pages_PageLockAcquire_Handler,
+		},
+		{
+			MethodName: "PageLockRenew",
+			Handler:    _Pages_PageLockRenew_Handler,
+		},
+		{
+			MethodName: "PageLockRelease",
+			Handler:    _Pages_PageLockRelease_Handler,
+		},
+		{
+			MethodName: "PageBatchCreate",
+			Handler:    _Pages_PageBatchCreate_Handler,
+		},
+		{
+			MethodName: "PageBatchUpdate",
+			Handler:    _Pages_PageBatchUpdate_Handler,
+		},
+		{
+			MethodName: "PageBatchDelete",
+			Handler:    _Pages_PageBatchDelete_Handler,
+		},
+		{
+			MethodName: "PageGet",
+			Handler:    _Pages_PageGet_Handler,
+		},
+		{
+			MethodName: "PageList",
+			Handler:    _Pages_PageList_Handler,
+		},
+		{
+			MethodName: "PageShare",
+			Handler:    _Pages_PageShare_Handler,
+		},
+		{
+			MethodName: "PageUnshare",
+			Handler:    _Pages_PageUnshare_Handler,
+		},
+		{
+			MethodName: "PageCollaborators",
+			Handler:    _Pages_PageCollaborators_Handler,
+		},
+		{
+			MethodName: "PageBacklinks",
+			Handler:    _Pages_PageBacklinks_Handler,
+		},
+		{
+			MethodName: "PageOutlinks",
+			Handler:    _Pages_PageOutlinks_Handler,
+		},
+		{
+			MethodName: "PageFork",
+			Handler:    _Pages_PageFork_Handler,
+		},
+		{
+			MethodName: "PageForks",
+			Handler:    _Pages_PageForks_Handler,
+		},
+		{
+			MethodName: "PageStats",
+			Handler:    _Pages_PageStats_Handler,
+		},
+		{
+			MethodName: "ChangesSince",
+			Handler:    _Pages_ChangesSince_Handler,
+		},
+		{
+			MethodName: "TemplateCreate",
+			Handler:    _Pages_TemplateCreate_Handler,
+		},
+		{
+			MethodName: "TemplateList",
+			Handler:    _Pages_TemplateList_Handler,
+		},
+		{
+			MethodName: "TemplateDelete",
+			Handler:    _Pages_TemplateDelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PageWatch",
+			Handler:       _Pages_PageWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Sync",
+			Handler:       _Pages_Sync_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachmentUpload",
+			Handler:       _Pages_AttachmentUpload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachmentDownload",
+			Handler:       _Pages_AttachmentDownload_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: fileDescriptor0,
+}
+
+// Client API for Comments service
+
+type CommentsClient interface {
+	CommentCreate(ctx context.Context, in *CommentCreateRequest, opts ...grpc.CallOption) (*Comment, error)
+	CommentUpdate(ctx context.Context, in *CommentUpdateRequest, opts ...grpc.CallOption) (*Comment, error)
+	CommentDelete(ctx context.Context, in *CommentDeleteRequest, opts ...grpc.CallOption) (*Comment, error)
+	CommentList(ctx context.Context, in *CommentListRequest, opts ...grpc.CallOption) (*CommentsSet, error)
+}
+
+type commentsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCommentsClient(cc *grpc.ClientConn) CommentsClient {
+	return &commentsClient{cc}
+}
+
+func (c *commentsClient) CommentCreate(ctx context.Context, in *CommentCreateRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := grpc.Invoke(ctx, "/Comments/CommentCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) CommentUpdate(ctx context.Context, in *CommentUpdateRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := grpc.Invoke(ctx, "/Comments/CommentUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) CommentDelete(ctx context.Context, in *CommentDeleteRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := grpc.Invoke(ctx, "/Comments/CommentDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) CommentList(ctx context.Context, in *CommentListRequest, opts ...grpc.CallOption) (*CommentsSet, error) {
+	out := new(CommentsSet)
+	err := grpc.Invoke(ctx, "/Comments/CommentList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Comments service
+
+type CommentsServer interface {
+	CommentCreate(context.Context, *CommentCreateRequest) (*Comment, error)
+	CommentUpdate(context.Context, *CommentUpdateRequest) (*Comment, error)
+	CommentDelete(context.Context, *CommentDeleteRequest) (*Comment, error)
+	CommentList(context.Context, *CommentListRequest) (*CommentsSet, error)
+}
+
+func RegisterCommentsServer(s *grpc.Server, srv CommentsServer) {
+	s.RegisterService(&_Comments_serviceDesc, srv)
+}
+
+func _Comments_CommentCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).CommentCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Comments/CommentCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).CommentCreate(ctx, req.(*CommentCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_CommentUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).CommentUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Comments/CommentUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).CommentUpdate(ctx, req.(*CommentUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_CommentDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).CommentDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Comments/CommentDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).CommentDelete(ctx, req.(*CommentDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_CommentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).CommentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Comments/CommentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).CommentList(ctx, req.(*CommentListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Comments_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Comments",
+	HandlerType: (*CommentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommentCreate",
+			Handler:    _Comments_CommentCreate_Handler,
+		},
+		{
+			MethodName: "CommentUpdate",
+			Handler:    _Comments_CommentUpdate_Handler,
+		},
+		{
+			MethodName: "CommentDelete",
+			Handler:    _Comments_CommentDelete_Handler,
+		},
+		{
+			MethodName: "CommentList",
+			Handler:    _Comments_CommentList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: fileDescriptor0,
+}
+
+// Client API for Moderation service
+
+type ModerationClient interface {
+	ModerationList(ctx context.Context, in *ModerationListRequest, opts ...grpc.CallOption) (*ModerationDecisionsSet, error)
+	ModerationReview(ctx context.Context, in *ModerationReviewRequest, opts ...grpc.CallOption) (*ModerationDecision, error)
+	PageFlag(ctx context.Context, in *PageFlagRequest, opts ...grpc.CallOption) (*ModerationDecision, error)
+}
+
+type moderationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewModerationClient(cc *grpc.ClientConn) ModerationClient {
+	return &moderationClient{cc}
+}
+
+func (c *moderationClient) ModerationList(ctx context.Context, in *ModerationListRequest, opts ...grpc.CallOption) (*ModerationDecisionsSet, error) {
+	out := new(ModerationDecisionsSet)
+	err := grpc.Invoke(ctx, "/Moderation/ModerationList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationClient) ModerationReview(ctx context.Context, in *ModerationReviewRequest, opts ...grpc.CallOption) (*ModerationDecision, error) {
+	out := new(ModerationDecision)
+	err := grpc.Invoke(ctx, "/Moderation/ModerationReview", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationClient) PageFlag(ctx context.Context, in *PageFlagRequest, opts ...grpc.CallOption) (*ModerationDecision, error) {
+	out := new(ModerationDecision)
+	err := grpc.Invoke(ctx, "/Moderation/PageFlag", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Moderation service
+
+type ModerationServer interface {
+	ModerationList(context.Context, *ModerationListRequest) (*ModerationDecisionsSet, error)
+	ModerationReview(context.Context, *ModerationReviewRequest) (*ModerationDecision, error)
+	PageFlag(context.Context, *PageFlagRequest) (*ModerationDecision, error)
+}
+
+func RegisterModerationServer(s *grpc.Server, srv ModerationServer) {
+	s.RegisterService(&_Moderation_serviceDesc, srv)
+}
+
+func _Moderation_ModerationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServer).ModerationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Moderation/ModerationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServer).ModerationList(ctx, req.(*ModerationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type PagesSet struct {
-	Pages []*Page `protobuf:"bytes,1,rep,name=pages" json:"pages,omitempty"`
-	Total int64   `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
-	Page  int64   `protobuf:"varint,3,opt,name=page" json:"page,omitempty"`
+func _Moderation_ModerationReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServer).ModerationReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Moderation/ModerationReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServer).ModerationReview(ctx, req.(*ModerationReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PagesSet) Reset()                    { *m = PagesSet{} }
-func (m *PagesSet) String() string            { return proto.CompactTextString(m) }
-func (*PagesSet) ProtoMessage()               {}
-func (*PagesSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
-
-func (m *PagesSet) GetPages() []*Page {
-	if m != nil {
-		return m.Pages
+func _Moderation_PageFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return nil
+	if interceptor == nil {
+		return srv.(ModerationServer).PageFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Moderation/PageFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServer).PageFlag(ctx, req.(*PageFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "Empty")
-	proto.RegisterType((*Account)(nil), "Account")
-	proto.RegisterType((*Session)(nil), "Session")
-	proto.RegisterType((*RegisterRequest)(nil), "RegisterRequest")
-	proto.RegisterType((*ConnectRequest)(nil), "ConnectRequest")
-	proto.RegisterType((*PageGetRequest)(nil), "PageGetRequest")
-	proto.RegisterType((*PageCreateRequest)(nil), "PageCreateRequest")
-	proto.RegisterType((*PageUpdateRequest)(nil), "PageUpdateRequest")
-	proto.RegisterType((*PageDeleteRequest)(nil), "PageDeleteRequest")
-	proto.RegisterType((*Page)(nil), "Page")
-	proto.RegisterType((*PagesSet)(nil), "PagesSet")
+var _Moderation_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Moderation",
+	HandlerType: (*ModerationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModerationList",
+			Handler:    _Moderation_ModerationList_Handler,
+		},
+		{
+			MethodName: "ModerationReview",
+			Handler:    _Moderation_ModerationReview_Handler,
+		},
+		{
+			MethodName: "PageFlag",
+			Handler:    _Moderation_PageFlag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: fileDescriptor0,
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// Client API for Webhooks service
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion3
+type WebhooksClient interface {
+	WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*Webhook, error)
+	WebhookList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WebhooksSet, error)
+	WebhookDelete(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*Webhook, error)
+	WebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesSet, error)
+}
 
-// Client API for Accounts service
+type webhooksClient struct {
+	cc *grpc.ClientConn
+}
 
-type AccountsClient interface {
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Session, error)
-	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Session, error)
+func NewWebhooksClient(cc *grpc.ClientConn) WebhooksClient {
+	return &webhooksClient{cc}
 }
 
-type accountsClient struct {
-	cc *grpc.ClientConn
+func (c *webhooksClient) WebhookCreate(ctx context.Context, in *WebhookCreateRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := grpc.Invoke(ctx, "/Webhooks/WebhookCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
-	return &accountsClient{cc}
+func (c *webhooksClient) WebhookList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WebhooksSet, error) {
+	out := new(WebhooksSet)
+	err := grpc.Invoke(ctx, "/Webhooks/WebhookList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *accountsClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*Session, error) {
-	out := new(Session)
-	err := grpc.Invoke(ctx, "/Accounts/Register", in, out, c.cc, opts...)
+func (c *webhooksClient) WebhookDelete(ctx context.Context, in *WebhookDeleteRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	out := new(Webhook)
+	err := grpc.Invoke(ctx, "/Webhooks/WebhookDelete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *accountsClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Session, error) {
-	out := new(Session)
-	err := grpc.Invoke(ctx, "/Accounts/Connect", in, out, c.cc, opts...)
+func (c *webhooksClient) WebhookDeliveries(ctx context.Context, in *WebhookDeliveriesRequest, opts ...grpc.CallOption) (*WebhookDeliveriesSet, error) {
+	out := new(WebhookDeliveriesSet)
+	err := grpc.Invoke(ctx, "/Webhooks/WebhookDeliveries", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Accounts service
+// Server API for Webhooks service
 
-type AccountsServer interface {
-	Register(context.Context, *RegisterRequest) (*Session, error)
-	Connect(context.Context, *ConnectRequest) (*Session, error)
+type WebhooksServer interface {
+	WebhookCreate(context.Context, *WebhookCreateRequest) (*Webhook, error)
+	WebhookList(context.Context, *Empty) (*WebhooksSet, error)
+	WebhookDelete(context.Context, *WebhookDeleteRequest) (*Webhook, error)
+	WebhookDeliveries(context.Context, *WebhookDeliveriesRequest) (*WebhookDeliveriesSet, error)
 }
 
-func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
-	s.RegisterService(&_Accounts_serviceDesc, srv)
+func RegisterWebhooksServer(s *grpc.Server, srv WebhooksServer) {
+	s.RegisterService(&_Webhooks_serviceDesc, srv)
 }
 
-func _Accounts_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _Webhooks_WebhookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServer).Register(ctx, in)
+		return srv.(WebhooksServer).WebhookCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Accounts/Register",
+		FullMethod: "/Webhooks/WebhookCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(WebhooksServer).WebhookCreate(ctx, req.(*WebhookCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Accounts_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectRequest)
+func _Webhooks_WebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServer).Connect(ctx, in)
+		return srv.(WebhooksServer).WebhookList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Accounts/Connect",
+		FullMethod: "/Webhooks/WebhookList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).Connect(ctx, req.(*ConnectRequest))
+		return srv.(WebhooksServer).WebhookList(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Accounts_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "Accounts",
-	HandlerType: (*AccountsServer)(nil),
+func _Webhooks_WebhookDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).WebhookDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Webhooks/WebhookDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).WebhookDelete(ctx, req.(*WebhookDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhooks_WebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).WebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Webhooks/WebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).WebhookDeliveries(ctx, req.(*WebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Webhooks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Webhooks",
+	HandlerType: (*WebhooksServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Register",
-			Handler:    _Accounts_Register_Handler,
+			MethodName: "WebhookCreate",
+			Handler:    _Webhooks_WebhookCreate_Handler,
 		},
 		{
-			MethodName: "Connect",
-			Handler:    _Accounts_Connect_Handler,
+			MethodName: "WebhookList",
+			Handler:    _Webhooks_WebhookList_Handler,
+		},
+		{
+			MethodName: "WebhookDelete",
+			Handler:    _Webhooks_WebhookDelete_Handler,
+		},
+		{
+			MethodName: "WebhookDeliveries",
+			Handler:    _Webhooks_WebhookDeliveries_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: fileDescriptor0,
 }
 
-// Client API for Pages service
+// Client API for Notebooks service
 
-type PagesClient interface {
-	PageCreate(ctx context.Context, in *PageCreateRequest, opts ...grpc.CallOption) (*Page, error)
-	PageUpdate(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*Page, error)
-	PageDelete(ctx context.Context, in *PageDeleteRequest, opts ...grpc.CallOption) (*Page, error)
-	PageGet(ctx context.Context, in *PageGetRequest, opts ...grpc.CallOption) (*Page, error)
-	PageList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PagesSet, error)
+type NotebooksClient interface {
+	NotebookCreate(ctx context.Context, in *NotebookCreateRequest, opts ...grpc.CallOption) (*Notebook, error)
+	NotebookList(ctx context.Context, in *NotebookListRequest, opts ...grpc.CallOption) (*NotebooksSet, error)
+	NotebookRename(ctx context.Context, in *NotebookRenameRequest, opts ...grpc.CallOption) (*Notebook, error)
+	NotebookMove(ctx context.Context, in *NotebookMoveRequest, opts ...grpc.CallOption) (*Notebook, error)
+	NotebookDelete(ctx context.Context, in *NotebookDeleteRequest, opts ...grpc.CallOption) (*Notebook, error)
+	NotebookPages(ctx context.Context, in *NotebookPagesRequest, opts ...grpc.CallOption) (*PagesSet, error)
+	PageMove(ctx context.Context, in *PageMoveRequest, opts ...grpc.CallOption) (*Page, error)
 }
 
-type pagesClient struct {
+type notebooksClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewPagesClient(cc *grpc.ClientConn) PagesClient {
-	return &pagesClient{cc}
+func NewNotebooksClient(cc *grpc.ClientConn) NotebooksClient {
+	return &notebooksClient{cc}
 }
 
-func (c *pagesClient) PageCreate(ctx context.Context, in *PageCreateRequest, opts ...grpc.CallOption) (*Page, error) {
-	out := new(Page)
-	err := grpc.Invoke(ctx, "/Pages/PageCreate", in, out, c.cc, opts...)
+func (c *notebooksClient) NotebookCreate(ctx context.Context, in *NotebookCreateRequest, opts ...grpc.CallOption) (*Notebook, error) {
+	out := new(Notebook)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookCreate", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pagesClient) PageUpdate(ctx context.Context, in *PageUpdateRequest, opts ...grpc.CallOption) (*Page, error) {
-	out := new(Page)
-	err := grpc.Invoke(ctx, "/Pages/PageUpdate", in, out, c.cc, opts...)
+func (c *notebooksClient) NotebookList(ctx context.Context, in *NotebookListRequest, opts ...grpc.CallOption) (*NotebooksSet, error) {
+	out := new(NotebooksSet)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookList", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pagesClient) PageDelete(ctx context.Context, in *PageDeleteRequest, opts ...grpc.CallOption) (*Page, error) {
-	out := new(Page)
-	err := grpc.Invoke(ctx, "/Pages/PageDelete", in, out, c.cc, opts...)
+func (c *notebooksClient) NotebookRename(ctx context.Context, in *NotebookRenameRequest, opts ...grpc.CallOption) (*Notebook, error) {
+	out := new(Notebook)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookRename", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pagesClient) PageGet(ctx context.Context, in *PageGetRequest, opts ...grpc.CallOption) (*Page, error) {
-	out := new(Page)
-	err := grpc.Invoke(ctx, "/Pages/PageGet", in, out, c.cc, opts...)
+func (c *notebooksClient) NotebookMove(ctx context.Context, in *NotebookMoveRequest, opts ...grpc.CallOption) (*Notebook, error) {
+	out := new(Notebook)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookMove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebooksClient) NotebookDelete(ctx context.Context, in *NotebookDeleteRequest, opts ...grpc.CallOption) (*Notebook, error) {
+	out := new(Notebook)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookDelete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *pagesClient) PageList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PagesSet, error) {
+func (c *notebooksClient) NotebookPages(ctx context.Context, in *NotebookPagesRequest, opts ...grpc.CallOption) (*PagesSet, error) {
 	out := new(PagesSet)
-	err := grpc.Invoke(ctx, "/Pages/PageList", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/Notebooks/NotebookPages", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Pages service
+func (c *notebooksClient) PageMove(ctx context.Context, in *PageMoveRequest, opts ...grpc.CallOption) (*Page, error) {
+	out := new(Page)
+	err := grpc.Invoke(ctx, "/Notebooks/PageMove", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type PagesServer interface {
-	PageCreate(context.Context, *PageCreateRequest) (*Page, error)
-	PageUpdate(context.Context, *PageUpdateRequest) (*Page, error)
-	PageDelete(context.Context, *PageDeleteRequest) (*Page, error)
-	PageGet(context.Context, *PageGetRequest) (*Page, error)
-	PageList(context.Context, *Empty) (*PagesSet, error)
+// Server API for Notebooks service
+
+type NotebooksServer interface {
+	NotebookCreate(context.Context, *NotebookCreateRequest) (*Notebook, error)
+	NotebookList(context.Context, *NotebookListRequest) (*NotebooksSet, error)
+	NotebookRename(context.Context, *NotebookRenameRequest) (*Notebook, error)
+	NotebookMove(context.Context, *NotebookMoveRequest) (*Notebook, error)
+	NotebookDelete(context.Context, *NotebookDeleteRequest) (*Notebook, error)
+	NotebookPages(context.Context, *NotebookPagesRequest) (*PagesSet, error)
+	PageMove(context.Context, *PageMoveRequest) (*Page, error)
 }
 
-func RegisterPagesServer(s *grpc.Server, srv PagesServer) {
-	s.RegisterService(&_Pages_serviceDesc, srv)
+func RegisterNotebooksServer(s *grpc.Server, srv NotebooksServer) {
+	s.RegisterService(&_Notebooks_serviceDesc, srv)
 }
 
-func _Pages_PageCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageCreateRequest)
+func _Notebooks_NotebookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PagesServer).PageCreate(ctx, in)
+		return srv.(NotebooksServer).NotebookCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Pages/PageCreate",
+		FullMethod: "/Notebooks/NotebookCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PagesServer).PageCreate(ctx, req.(*PageCreateRequest))
+		return srv.(NotebooksServer).NotebookCreate(ctx, req.(*NotebookCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pages_PageUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageUpdateRequest)
+func _Notebooks_NotebookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PagesServer).PageUpdate(ctx, in)
+		return srv.(NotebooksServer).NotebookList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Pages/PageUpdate",
+		FullMethod: "/Notebooks/NotebookList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PagesServer).PageUpdate(ctx, req.(*PageUpdateRequest))
+		return srv.(NotebooksServer).NotebookList(ctx, req.(*NotebookListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pages_PageDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageDeleteRequest)
+func _Notebooks_NotebookRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookRenameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PagesServer).PageDelete(ctx, in)
+		return srv.(NotebooksServer).NotebookRename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Pages/PageDelete",
+		FullMethod: "/Notebooks/NotebookRename",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PagesServer).PageDelete(ctx, req.(*PageDeleteRequest))
+		return srv.(NotebooksServer).NotebookRename(ctx, req.(*NotebookRenameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pages_PageGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PageGetRequest)
+func _Notebooks_NotebookMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookMoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PagesServer).PageGet(ctx, in)
+		return srv.(NotebooksServer).NotebookMove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Pages/PageGet",
+		FullMethod: "/Notebooks/NotebookMove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PagesServer).PageGet(ctx, req.(*PageGetRequest))
+		return srv.(NotebooksServer).NotebookMove(ctx, req.(*NotebookMoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Pages_PageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Notebooks_NotebookDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PagesServer).PageList(ctx, in)
+		return srv.(NotebooksServer).NotebookDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Pages/PageList",
+		FullMethod: "/Notebooks/NotebookDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PagesServer).PageList(ctx, req.(*Empty))
+		return srv.(NotebooksServer).NotebookDelete(ctx, req.(*NotebookDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Pages_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "Pages",
-	HandlerType: (*PagesServer)(nil),
+func _Notebooks_NotebookPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotebookPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebooksServer).NotebookPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Notebooks/NotebookPages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebooksServer).NotebookPages(ctx, req.(*NotebookPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notebooks_PageMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebooksServer).PageMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Notebooks/PageMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebooksServer).PageMove(ctx, req.(*PageMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Notebooks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Notebooks",
+	HandlerType: (*NotebooksServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "PageCreate",
-			Handler:    _Pages_PageCreate_Handler,
+			MethodName: "NotebookCreate",
+			Handler:    _Notebooks_NotebookCreate_Handler,
 		},
 		{
-			MethodName: "PageUpdate",
-			Handler:    _Pages_PageUpdate_Handler,
+			MethodName: "NotebookList",
+			Handler:    _Notebooks_NotebookList_Handler,
 		},
 		{
-			MethodName: "PageDelete",
-			Handler:    _Pages_PageDelete_Handler,
+			MethodName: "NotebookRename",
+			Handler:    _Notebooks_NotebookRename_Handler,
 		},
 		{
-			MethodName: "PageGet",
-			Handler:    _Pages_PageGet_Handler,
+			MethodName: "NotebookMove",
+			Handler:    _Notebooks_NotebookMove_Handler,
 		},
 		{
-			MethodName: "PageList",
-			Handler:    _Pages_PageList_Handler,
+			MethodName: "NotebookDelete",
+			Handler:    _Notebooks_NotebookDelete_Handler,
+		},
+		{
+			MethodName: "NotebookPages",
+			Handler:    _Notebooks_NotebookPages_Handler,
+		},
+		{
+			MethodName: "PageMove",
+			Handler:    _Notebooks_PageMove_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -496,41 +3956,269 @@ var _Pages_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pages.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0x65, 0xbb, 0x8e, 0x9d, 0xc9, 0x4f, 0x49, 0xb3, 0xfa, 0x01, 0x96, 0x5b, 0xa1, 0x68,
-	0x39, 0x10, 0x55, 0x62, 0x23, 0x05, 0x24, 0xa4, 0x9e, 0xa8, 0x52, 0xc4, 0xa5, 0x07, 0x70, 0x85,
-	0x38, 0x9b, 0x78, 0xb0, 0x56, 0x24, 0x5e, 0xe3, 0xdd, 0x08, 0x7a, 0x45, 0xbc, 0x41, 0xdf, 0x84,
-	0x57, 0xe1, 0x15, 0x78, 0x10, 0xb4, 0x7f, 0x9c, 0x3a, 0x69, 0x73, 0xf3, 0xec, 0xcc, 0x7c, 0xf7,
-	0x3b, 0x3b, 0x1f, 0x19, 0x06, 0x75, 0x5e, 0xa2, 0x64, 0x75, 0x23, 0x94, 0x48, 0x4f, 0x4b, 0x21,
-	0xca, 0x15, 0xce, 0xf2, 0x9a, 0xcf, 0xf2, 0xaa, 0x12, 0x2a, 0x57, 0x5c, 0x54, 0x2e, 0x4b, 0x23,
-	0x08, 0xdf, 0xae, 0x6b, 0x75, 0x43, 0x6f, 0x20, 0xba, 0x58, 0x2e, 0xc5, 0xa6, 0x52, 0x64, 0x08,
-	0x3e, 0x2f, 0x12, 0x6f, 0xe2, 0x4d, 0xfb, 0x99, 0xcf, 0x0b, 0x42, 0xe0, 0xa8, 0xca, 0xd7, 0x98,
-	0xf8, 0xe6, 0xc4, 0x7c, 0x93, 0xff, 0x21, 0xc4, 0x75, 0xce, 0x57, 0x49, 0x60, 0x0e, 0x6d, 0x40,
-	0x12, 0x88, 0x96, 0x0d, 0xe6, 0x0a, 0x8b, 0x24, 0x9c, 0x78, 0xd3, 0x20, 0x6b, 0x43, 0x92, 0x42,
-	0xbc, 0x16, 0x05, 0xff, 0xc2, 0xb1, 0x48, 0x7a, 0x26, 0xb5, 0x8d, 0xe9, 0x02, 0xa2, 0x6b, 0x94,
-	0x92, 0x8b, 0x8a, 0x50, 0x88, 0x72, 0xeb, 0xc2, 0xdc, 0x3f, 0x98, 0xc7, 0xcc, 0xb9, 0xca, 0xda,
-	0x84, 0xbe, 0x5a, 0x89, 0xaf, 0x58, 0x39, 0x3f, 0x36, 0xa0, 0x9f, 0x60, 0x94, 0x61, 0xc9, 0xa5,
-	0xc2, 0x26, 0xc3, 0x6f, 0x1b, 0x94, 0x6a, 0xeb, 0xdb, 0x7b, 0xc8, 0xb7, 0xdf, 0xf5, 0x9d, 0x42,
-	0x5c, 0xe7, 0x52, 0x7e, 0x17, 0x4d, 0xe1, 0x06, 0xda, 0xc6, 0xf4, 0x0a, 0x86, 0x0b, 0x51, 0x55,
-	0xb8, 0x54, 0xad, 0xee, 0x53, 0x00, 0x5e, 0x60, 0xa5, 0xb4, 0xfb, 0xc6, 0xa9, 0x77, 0x4e, 0x76,
-	0xd4, 0xfc, 0x3d, 0xb5, 0x09, 0x0c, 0xdf, 0xe7, 0x25, 0xbe, 0xc3, 0xad, 0xda, 0xde, 0x6b, 0xd3,
-	0xe7, 0x30, 0xd6, 0x15, 0x0b, 0xf3, 0x70, 0x9d, 0x51, 0x14, 0xfe, 0x50, 0xed, 0x28, 0xfa, 0x9b,
-	0xbe, 0xb6, 0x85, 0x1f, 0xeb, 0xa2, 0x53, 0xf8, 0xc0, 0xee, 0x4c, 0xa3, 0xdf, 0x69, 0x7c, 0x66,
-	0x1b, 0x2f, 0x71, 0x85, 0x07, 0x1b, 0xe9, 0x2f, 0x0f, 0x8e, 0x74, 0xd5, 0x3d, 0xc5, 0xce, 0x8a,
-	0xfc, 0x43, 0x2b, 0x6a, 0x6f, 0x0d, 0xee, 0x6e, 0xed, 0xb2, 0x71, 0x74, 0x98, 0x8d, 0x70, 0x8f,
-	0x8d, 0x0f, 0x10, 0x6b, 0x17, 0xf2, 0x1a, 0x15, 0x39, 0x81, 0xd0, 0x80, 0x9d, 0x78, 0x93, 0x60,
-	0x3a, 0x98, 0x87, 0x4c, 0x67, 0x32, 0x7b, 0x66, 0xa9, 0x50, 0xb9, 0x5d, 0x6c, 0x90, 0xd9, 0x40,
-	0x1b, 0xd1, 0x69, 0x63, 0x24, 0xc8, 0xcc, 0xf7, 0xfc, 0xd6, 0x83, 0xd8, 0x39, 0x96, 0xe4, 0x12,
-	0xe2, 0x16, 0x1b, 0x72, 0xcc, 0xf6, 0x08, 0x4a, 0x63, 0xe6, 0xc0, 0xa4, 0xa7, 0x3f, 0xff, 0xfc,
-	0xbd, 0xf5, 0x1f, 0xd3, 0xf1, 0xcc, 0xcd, 0xc8, 0x1a, 0x57, 0x7b, 0xee, 0x9d, 0x91, 0x0b, 0x88,
-	0x1c, 0x23, 0x64, 0xc4, 0x76, 0x69, 0xe9, 0x68, 0x9c, 0x18, 0x8d, 0x47, 0xf4, 0x78, 0xab, 0xb1,
-	0xb4, 0xa5, 0xe7, 0xde, 0xd9, 0xfc, 0xb7, 0x0f, 0xa1, 0x99, 0x94, 0xbc, 0x01, 0xb8, 0x03, 0x80,
-	0x10, 0x76, 0x8f, 0x86, 0xd4, 0x4e, 0x4e, 0x9f, 0x18, 0xbd, 0x31, 0xfd, 0x6f, 0xa6, 0xe7, 0x62,
-	0xf6, 0x39, 0xb5, 0x1d, 0xa7, 0x60, 0xc9, 0x70, 0x0a, 0x3b, 0x98, 0x1c, 0x50, 0xd8, 0x98, 0x9a,
-	0x8e, 0x82, 0x45, 0xc4, 0x29, 0xec, 0xf0, 0x72, 0x40, 0xa1, 0x30, 0x35, 0x5a, 0xe1, 0x15, 0x44,
-	0x0e, 0x74, 0x32, 0x62, 0xbb, 0xc8, 0xb7, 0xbd, 0x63, 0xd3, 0x3b, 0x20, 0x7d, 0xdb, 0x5b, 0xa2,
-	0x22, 0x2f, 0xec, 0xba, 0xaf, 0xb8, 0x54, 0xa4, 0xc7, 0xcc, 0x9f, 0x29, 0xed, 0xb3, 0x96, 0x00,
-	0x3a, 0x34, 0x1d, 0x31, 0xe9, 0x99, 0x0e, 0xf9, 0xb9, 0x67, 0x7e, 0x62, 0x2f, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xec, 0x29, 0x2e, 0x78, 0xf1, 0x04, 0x00, 0x00,
+	// 4220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x7a, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x76, 0xb0, 0x9b, 0xa4, 0xf8, 0x38, 0x7c, 0xa8, 0x55, 0x7a, 0x98, 0xe6, 0xcc, 0xdc, 0x99, 0xa9,
+	0xf1, 0xe7, 0xf1, 0xd5, 0xe0, 0x96, 0xe6, 0x93, 0xef, 0xbc, 0x7c, 0x93, 0x7b, 0x87, 0x96, 0x28,
+	0x9b, 0x8e, 0x2c, 0xc9, 0x2d, 0xc9, 0x06, 0x26, 0xc0, 0x28, 0x2d, 0xb2, 0x24, 0x35, 0x44, 0x76,
+	0x73, 0xba, 0x5b, 0xb2, 0x15, 0x20, 0x08, 0x70, 0x57, 0xc9, 0x22, 0xab, 0x24, 0x08, 0x90, 0x45,
+	0x80, 0x2c, 0xb3, 0x0a, 0x90, 0x64, 0x91, 0x4d, 0x10, 0x24, 0x7f, 0x21, 0xab, 0xec, 0xf3, 0x3f,
+	0x12, 0xd4, 0xb3, 0xab, 0x9b, 0x4d, 0x5a, 0x33, 0x41, 0x76, 0xf5, 0x3c, 0x75, 0xde, 0x75, 0xea,
+	0x9c, 0x82, 0xfa, 0xc4, 0x3d, 0xa7, 0x11, 0x99, 0x84, 0x41, 0x1c, 0x74, 0xde, 0x3f, 0x0f, 0x82,
+	0xf3, 0x11, 0xdd, 0x70, 0x27, 0xde, 0x86, 0xeb, 0xfb, 0x41, 0xec, 0xc6, 0x5e, 0xe0, 0xab, 0xd9,
+	0x8f, 0xe4, 0x2c, 0xef, 0x9d, 0x5e, 0x9d, 0x6d, 0x9c, 0x79, 0x74, 0x34, 0x3c, 0x19, 0xbb, 0xd1,
+	0xa5, 0x58, 0x81, 0x2b, 0xb0, 0xd0, 0x1b, 0x4f, 0xe2, 0x1b, 0xfc, 0x67, 0x16, 0x54, 0xba, 0x83,
+	0x41, 0x70, 0xe5, 0xc7, 0xa8, 0x05, 0x05, 0x6f, 0xd8, 0xb6, 0x3e, 0xb2, 0x1e, 0xd6, 0x9c, 0x82,
+	0x37, 0x44, 0x08, 0x4a, 0xbe, 0x3b, 0xa6, 0xed, 0x02, 0x1f, 0xe1, 0x6d, 0xb4, 0x02, 0x0b, 0x74,
+	0xec, 0x7a, 0xa3, 0x76, 0x91, 0x0f, 0x8a, 0x0e, 0x6a, 0x43, 0x65, 0x10, 0x52, 0x37, 0xa6, 0xc3,
+	0xf6, 0xc2, 0x47, 0xd6, 0xc3, 0xa2, 0xa3, 0xba, 0xa8, 0x03, 0xd5, 0x71, 0x30, 0xf4, 0xce, 0x3c,
+	0x3a, 0x6c, 0x97, 0xf9, 0x94, 0xee, 0x33, 0xf8, 0x93, 0x91, 0xeb, 0xb7, 0x2b, 0x02, 0x3e, 0x6b,
+	0xe3, 0x2d, 0xa8, 0x1c, 0xd2, 0x28, 0xf2, 0x02, 0x1f, 0x61, 0xa8, 0xb8, 0x02, 0x33, 0x8e, 0x53,
+	0x7d, 0xb3, 0x4a, 0x24, 0xa6, 0x8e, 0x9a, 0x60, 0xe8, 0xc4, 0xc1, 0x25, 0xf5, 0x25, 0x8e, 0xa2,
+	0x83, 0x5f, 0xc3, 0xa2, 0x43, 0xcf, 0xbd, 0x28, 0xa6, 0xa1, 0x43, 0x7f, 0xb8, 0xa2, 0x51, 0xac,
+	0x69, 0xb1, 0xf2, 0x68, 0x29, 0x98, 0xb4, 0x74, 0xa0, 0x3a, 0x71, 0xa3, 0xe8, 0x4d, 0x10, 0x0e,
+	0x25, 0x91, 0xba, 0x8f, 0x77, 0xa1, 0xb5, 0x15, 0xf8, 0x3e, 0x1d, 0xc4, 0x0a, 0xee, 0xcf, 0x00,
+	0xbc, 0x21, 0xf5, 0x63, 0x46, 0x51, 0x28, 0xa1, 0x1b, 0x23, 0x29, 0x68, 0x85, 0x0c, 0xb4, 0x5f,
+	0xc3, 0x8a, 0x24, 0xa8, 0xf7, 0x76, 0x12, 0x84, 0x1a, 0xe6, 0x03, 0x28, 0x9f, 0x05, 0xe1, 0xd8,
+	0x15, 0x74, 0xb7, 0x36, 0x5b, 0xa4, 0x1b, 0x0e, 0x2e, 0xbc, 0x6b, 0xba, 0xc3, 0x47, 0x1d, 0x39,
+	0x8b, 0x31, 0x34, 0xe4, 0xc4, 0xd6, 0xc5, 0x95, 0x7f, 0xc9, 0x68, 0x1c, 0xba, 0xb1, 0xcb, 0x77,
+	0x35, 0x1c, 0xde, 0xc6, 0x7f, 0x0c, 0xcb, 0xf2, 0x8c, 0xfe, 0x58, 0x9c, 0x11, 0x5d, 0x8d, 0x62,
+	0x53, 0x60, 0x56, 0x5a, 0x60, 0x6d, 0xa8, 0x5c, 0x4d, 0x86, 0x7c, 0xa6, 0x20, 0x66, 0x64, 0x17,
+	0xbd, 0x0f, 0xb5, 0x2b, 0x7f, 0x70, 0xe1, 0xfa, 0xe7, 0x54, 0x70, 0xa6, 0xe8, 0x24, 0x03, 0x68,
+	0x0d, 0xca, 0x34, 0x0c, 0x83, 0x30, 0x6a, 0x97, 0x3e, 0x2a, 0x3e, 0xac, 0x39, 0xb2, 0x87, 0xff,
+	0xde, 0x82, 0x85, 0x97, 0x57, 0x41, 0xec, 0x6a, 0x71, 0x5b, 0x89, 0xb8, 0x99, 0x08, 0xb8, 0x5a,
+	0xcb, 0xb3, 0x44, 0x07, 0xbd, 0x07, 0xb5, 0xb1, 0xfb, 0xf6, 0x44, 0xcc, 0x14, 0xa5, 0xd6, 0xb8,
+	0x6f, 0x0f, 0xf8, 0x64, 0x1b, 0x2a, 0x51, 0x1c, 0x84, 0xee, 0x39, 0x6d, 0x97, 0x04, 0x82, 0xb2,
+	0x8b, 0x3e, 0x84, 0x3a, 0xdb, 0xa6, 0x66, 0x85, 0x26, 0xc2, 0xd8, 0x7d, 0x7b, 0x28, 0x17, 0xdc,
+	0x87, 0x16, 0x5b, 0x10, 0xd3, 0xb7, 0xf1, 0xc9, 0xe9, 0x4d, 0x4c, 0x23, 0xa9, 0x92, 0x8d, 0xb1,
+	0xfb, 0xf6, 0x88, 0xbe, 0x8d, 0x9f, 0xb0, 0x31, 0xfc, 0x1c, 0x56, 0x25, 0xcb, 0x0e, 0x46, 0xae,
+	0x7f, 0x48, 0xb5, 0x5c, 0x3e, 0x00, 0x90, 0x7a, 0x77, 0xa2, 0xed, 0xa4, 0x26, 0x47, 0xfa, 0x89,
+	0x3a, 0x17, 0x0c, 0x75, 0xfe, 0x02, 0x5a, 0x0c, 0xeb, 0xa7, 0x09, 0x90, 0xac, 0x91, 0x2d, 0xc3,
+	0x82, 0x1b, 0x9d, 0x04, 0x67, 0x92, 0x03, 0x25, 0x37, 0xda, 0x3f, 0xc3, 0x0f, 0x60, 0x91, 0x6d,
+	0xdb, 0xf5, 0x22, 0xbd, 0x4f, 0xaf, 0xb3, 0x8c, 0x75, 0xff, 0x5c, 0x80, 0x25, 0xb6, 0x70, 0x8b,
+	0x0b, 0xcf, 0xd0, 0x75, 0x46, 0xa2, 0x62, 0x34, 0x6b, 0xa3, 0xcf, 0x00, 0xae, 0xbd, 0xc8, 0x3b,
+	0xf5, 0x46, 0x5e, 0x7c, 0xc3, 0xcf, 0x6a, 0x6d, 0xd6, 0xc9, 0x2b, 0x3d, 0xe4, 0x18, 0xd3, 0x8c,
+	0x91, 0x31, 0x1d, 0x4f, 0x46, 0x6e, 0x4c, 0x19, 0xa5, 0xc2, 0x0a, 0x40, 0x0d, 0xf5, 0x87, 0xe8,
+	0x37, 0x50, 0xbb, 0x76, 0x43, 0xcf, 0x3d, 0x1d, 0x51, 0x21, 0xef, 0xfa, 0xe6, 0xc7, 0x64, 0x0a,
+	0x11, 0xf2, 0x4a, 0xad, 0xe9, 0xf9, 0x71, 0x78, 0xe3, 0x24, 0x7b, 0xd0, 0x27, 0x50, 0x8e, 0x62,
+	0x37, 0xbe, 0x8a, 0xda, 0x0b, 0x12, 0x15, 0xb6, 0xfb, 0x90, 0x0f, 0x39, 0x72, 0x8a, 0xf1, 0x7b,
+	0x72, 0x75, 0x3a, 0xf2, 0xa2, 0x8b, 0x13, 0x37, 0x96, 0xa2, 0xaa, 0xc9, 0x91, 0x6e, 0xdc, 0xf9,
+	0x1d, 0x68, 0xa5, 0x0f, 0x40, 0x36, 0x14, 0x2f, 0xe9, 0x8d, 0xa4, 0x9b, 0x35, 0x99, 0x7e, 0x5d,
+	0xbb, 0xa3, 0x2b, 0xe5, 0xc3, 0x44, 0xe7, 0x71, 0xe1, 0x6b, 0x0b, 0xff, 0xad, 0x25, 0x58, 0x77,
+	0x3c, 0x19, 0x26, 0x18, 0xe7, 0xb9, 0x40, 0xce, 0xca, 0xc2, 0x4c, 0x56, 0x16, 0xe7, 0xb3, 0xf2,
+	0x57, 0x50, 0x17, 0xf6, 0xc3, 0xbd, 0x2f, 0xd7, 0xd8, 0xfa, 0x66, 0x87, 0x08, 0x07, 0x4d, 0x94,
+	0x83, 0x26, 0x3b, 0xcc, 0x41, 0xbf, 0x70, 0xa3, 0x4b, 0x07, 0xc4, 0x72, 0xd6, 0xc6, 0x63, 0x28,
+	0x33, 0xb5, 0xdc, 0x9f, 0xa0, 0x0f, 0xa1, 0x14, 0xdf, 0x4c, 0xa8, 0x74, 0x08, 0x75, 0x22, 0x86,
+	0x8f, 0x6e, 0x26, 0xd4, 0xe1, 0x13, 0xcc, 0xfc, 0x82, 0xb3, 0xb3, 0x88, 0xc6, 0x52, 0x8f, 0x64,
+	0x4f, 0x13, 0x50, 0x34, 0x08, 0x58, 0x83, 0xf2, 0x88, 0xfa, 0xe7, 0xf1, 0x85, 0x34, 0x20, 0xd9,
+	0xc3, 0x31, 0xd8, 0x8c, 0x23, 0x07, 0x6e, 0x3c, 0xb8, 0x98, 0xc5, 0x90, 0x8f, 0xa1, 0x71, 0xea,
+	0x46, 0xf4, 0xe4, 0x9a, 0x86, 0xcc, 0x49, 0xcb, 0xd3, 0xea, 0x6c, 0xec, 0x95, 0x18, 0x42, 0xf7,
+	0xa0, 0x18, 0x4c, 0x98, 0xdd, 0x32, 0xb5, 0xa8, 0x48, 0x54, 0x1d, 0x36, 0xc6, 0x3d, 0x94, 0x77,
+	0x76, 0xc6, 0xcf, 0xad, 0x39, 0xbc, 0x8d, 0xc7, 0x70, 0x37, 0x91, 0xfd, 0x7c, 0x69, 0x24, 0x5a,
+	0x53, 0xb8, 0xad, 0xd6, 0x14, 0x33, 0x5a, 0x83, 0x3f, 0x11, 0x62, 0xdf, 0xa6, 0x23, 0x3a, 0xf3,
+	0x20, 0xfc, 0x47, 0x50, 0xe5, 0xf6, 0x17, 0x0c, 0x2e, 0xd1, 0x5d, 0xa8, 0x30, 0x47, 0x94, 0x98,
+	0x7c, 0x99, 0x75, 0xfb, 0x43, 0xf3, 0x7e, 0x2a, 0xcc, 0xba, 0x9f, 0x3a, 0x50, 0x75, 0x07, 0x3f,
+	0x5c, 0x79, 0xa1, 0x76, 0x99, 0xba, 0xcf, 0x1c, 0x19, 0x7d, 0x3b, 0xf1, 0x42, 0x6e, 0x42, 0xdc,
+	0x91, 0xc9, 0x2e, 0x7e, 0x24, 0xcd, 0x3f, 0x18, 0x5c, 0xce, 0x62, 0x85, 0x0d, 0xc5, 0x38, 0x1e,
+	0x49, 0xf6, 0xb3, 0x26, 0x3e, 0x85, 0x35, 0xb6, 0xe9, 0x09, 0x93, 0x5e, 0xda, 0x1f, 0x3c, 0x54,
+	0x4e, 0xd6, 0xe2, 0x22, 0x41, 0xd3, 0x96, 0xaa, 0x1c, 0xef, 0xcf, 0xa0, 0x34, 0x0e, 0x86, 0x54,
+	0xb2, 0x17, 0x08, 0x07, 0xf6, 0x22, 0x18, 0x52, 0x87, 0x8f, 0xa7, 0xce, 0x48, 0x8b, 0x2a, 0xf7,
+	0x8c, 0xd4, 0x92, 0xdb, 0x9e, 0xf1, 0xdc, 0x38, 0x23, 0x2d, 0x25, 0x1b, 0x8a, 0xde, 0x50, 0x9c,
+	0x50, 0x73, 0x58, 0xf3, 0x9d, 0xb0, 0x8e, 0xa0, 0xa9, 0x61, 0xf5, 0x63, 0x3a, 0x46, 0xf7, 0xa0,
+	0xc4, 0xb0, 0x90, 0x01, 0xc5, 0x02, 0xc7, 0xd2, 0xe1, 0x43, 0x4c, 0x37, 0x07, 0x0a, 0xd6, 0x82,
+	0xc3, 0xdb, 0x3c, 0x42, 0x60, 0xd7, 0x98, 0x8e, 0x76, 0x58, 0x07, 0x1f, 0xc3, 0xa2, 0x86, 0x2a,
+	0xef, 0xd3, 0xfb, 0xb0, 0xe0, 0xc5, 0x74, 0xac, 0xc8, 0x6f, 0x91, 0xd4, 0xb1, 0x8e, 0x98, 0x64,
+	0x37, 0xe8, 0x20, 0x18, 0x8f, 0xbd, 0x58, 0xdd, 0xae, 0x55, 0x27, 0x19, 0xc0, 0x7f, 0x51, 0x84,
+	0x12, 0xdb, 0x36, 0x25, 0xeb, 0xdb, 0x28, 0x5a, 0x9e, 0x9d, 0x1b, 0x97, 0x7c, 0x69, 0x76, 0x54,
+	0xb6, 0x90, 0x89, 0xca, 0xd2, 0xee, 0xad, 0x3c, 0xdf, 0xbd, 0xb5, 0xa1, 0xa2, 0x3c, 0x41, 0x45,
+	0x1c, 0x21, 0xbb, 0xe8, 0x17, 0x50, 0x77, 0xe3, 0xd8, 0x1d, 0x5c, 0x8c, 0xa9, 0x1f, 0x47, 0xed,
+	0x2a, 0xe7, 0x4b, 0x9d, 0x74, 0xf5, 0x98, 0x63, 0xce, 0xf3, 0x40, 0xce, 0x8b, 0x47, 0xb4, 0x5d,
+	0x93, 0x81, 0x1c, 0xeb, 0x18, 0x06, 0x0f, 0xb7, 0x35, 0xf8, 0x7a, 0xc6, 0xe0, 0xd1, 0x07, 0x50,
+	0x1a, 0x05, 0x83, 0xcb, 0x76, 0x83, 0xb3, 0xae, 0x46, 0xb4, 0x65, 0xf1, 0x61, 0x76, 0xd7, 0x9d,
+	0x05, 0xe1, 0x25, 0x1d, 0x9e, 0x9c, 0x85, 0xc1, 0xb8, 0xdd, 0x14, 0x77, 0x9d, 0x18, 0xda, 0x09,
+	0x83, 0x31, 0x7e, 0x29, 0x7c, 0x41, 0x74, 0x48, 0x63, 0xf4, 0x5e, 0x5a, 0xcb, 0xa5, 0xfe, 0x88,
+	0x31, 0x11, 0x8b, 0xc6, 0xae, 0x32, 0x4a, 0xd1, 0x41, 0x48, 0x6a, 0x9c, 0xb0, 0x7e, 0xde, 0xc6,
+	0x87, 0xc2, 0xd1, 0x1e, 0x5e, 0xb8, 0xe1, 0x4c, 0x5f, 0x97, 0x1f, 0x9c, 0xde, 0x83, 0x52, 0x18,
+	0x8c, 0xa8, 0xbc, 0x75, 0x16, 0x88, 0x13, 0x8c, 0xa8, 0xc3, 0x87, 0xf0, 0x63, 0x40, 0xdc, 0xe6,
+	0xfc, 0xe8, 0x47, 0x83, 0xc5, 0xeb, 0xd0, 0xe6, 0x3e, 0x21, 0x18, 0x8d, 0xdc, 0xd3, 0x20, 0x74,
+	0xe3, 0x20, 0x8c, 0x66, 0xf9, 0xc6, 0x73, 0x68, 0x98, 0xeb, 0x6e, 0x15, 0xa6, 0x2b, 0xb4, 0x0b,
+	0x53, 0x68, 0x9b, 0x4a, 0x5a, 0x4c, 0x29, 0x29, 0x7e, 0x0a, 0x76, 0x0a, 0x21, 0x26, 0x80, 0x47,
+	0xd0, 0x1c, 0x98, 0x63, 0x52, 0x10, 0x4d, 0x62, 0xae, 0x74, 0xd2, 0x6b, 0x30, 0x16, 0xec, 0xde,
+	0xf5, 0xfc, 0xcb, 0x99, 0x54, 0x51, 0x61, 0xd3, 0x3b, 0x41, 0x78, 0xf9, 0x7f, 0x79, 0xfb, 0x48,
+	0x54, 0xd8, 0x31, 0x33, 0x51, 0xf9, 0x4a, 0x5e, 0x3e, 0x9e, 0x7f, 0xc9, 0x5c, 0x5e, 0x48, 0xcf,
+	0xe4, 0x24, 0x6b, 0x6a, 0x0f, 0x56, 0x98, 0xf2, 0x60, 0x78, 0x03, 0x1a, 0x9a, 0x4e, 0xc6, 0xac,
+	0x0f, 0x61, 0x61, 0xc4, 0xda, 0x92, 0x49, 0x52, 0xf5, 0x3d, 0xff, 0xd2, 0x11, 0xe3, 0xf8, 0x4b,
+	0xa9, 0x87, 0xb1, 0x1b, 0x47, 0x73, 0x22, 0xa0, 0xa1, 0x7b, 0xa3, 0x02, 0x74, 0xde, 0xc6, 0x5f,
+	0x8b, 0xa8, 0xf6, 0x95, 0x47, 0xdf, 0x3c, 0xb9, 0x1a, 0x5c, 0x52, 0xee, 0x9a, 0x87, 0xee, 0x8d,
+	0x8c, 0x4d, 0x59, 0x93, 0x47, 0x5e, 0x1e, 0x7d, 0xa3, 0x23, 0x7b, 0xde, 0xc1, 0xdf, 0x42, 0x53,
+	0xed, 0xdc, 0x52, 0x9a, 0x31, 0xcb, 0x21, 0xe7, 0x43, 0xb8, 0x81, 0x45, 0x03, 0x67, 0xee, 0x7c,
+	0x3f, 0x91, 0x28, 0x0a, 0x32, 0x17, 0x49, 0x1a, 0x37, 0x81, 0xf3, 0x0c, 0xeb, 0xfc, 0x0c, 0x6a,
+	0x71, 0x30, 0xd1, 0x2f, 0x8d, 0xc4, 0x77, 0x6b, 0x0c, 0x9d, 0x6a, 0x1c, 0x4c, 0xd8, 0x48, 0x84,
+	0xbb, 0x82, 0x5d, 0xaf, 0xe7, 0xc5, 0x47, 0xe9, 0x37, 0x42, 0x21, 0xf3, 0x46, 0xc0, 0x43, 0xa8,
+	0x31, 0x10, 0xbd, 0x6b, 0xea, 0xc7, 0x08, 0xa7, 0x82, 0xba, 0x16, 0xd1, 0x33, 0x46, 0x5c, 0x37,
+	0x5b, 0xdc, 0x73, 0x2c, 0x67, 0x0b, 0x96, 0xb7, 0xf8, 0xb3, 0x2c, 0x3a, 0xf4, 0xfc, 0x81, 0xf6,
+	0x05, 0x6b, 0x50, 0x1e, 0x5c, 0x85, 0x51, 0x10, 0x4a, 0x39, 0xc9, 0x1e, 0x63, 0xcd, 0xc8, 0x1b,
+	0x7b, 0x2a, 0x74, 0x14, 0x1d, 0xfc, 0xd7, 0x16, 0x00, 0x77, 0x0a, 0x1c, 0x12, 0xbb, 0x32, 0x22,
+	0x06, 0xc7, 0x1f, 0x50, 0xb9, 0x5d, 0xf7, 0x35, 0x21, 0x85, 0x39, 0x84, 0x18, 0x61, 0x54, 0x31,
+	0x15, 0x46, 0x29, 0x0a, 0x4b, 0x73, 0x29, 0x4c, 0xa7, 0x15, 0xf0, 0x09, 0x80, 0xa2, 0x90, 0xc6,
+	0xe8, 0xff, 0x41, 0x45, 0x3c, 0x43, 0x95, 0x0e, 0x08, 0xd3, 0x14, 0x2b, 0x1c, 0x35, 0x67, 0xd0,
+	0x5f, 0x48, 0xd1, 0x8f, 0x58, 0x14, 0x11, 0x0a, 0xa7, 0x5a, 0x75, 0x78, 0x1b, 0xff, 0xab, 0x05,
+	0x70, 0x78, 0xe3, 0x0f, 0x24, 0xf5, 0xd3, 0x76, 0xf8, 0xbf, 0xa2, 0x39, 0x1b, 0x45, 0x97, 0xa6,
+	0xa3, 0x68, 0x75, 0xa1, 0x2f, 0xcc, 0x7c, 0x79, 0xcc, 0xbf, 0x9a, 0xf1, 0x2e, 0xd4, 0x19, 0x01,
+	0xef, 0x12, 0xbe, 0xc1, 0xbb, 0x82, 0xe4, 0x5d, 0x42, 0xb7, 0xe6, 0x1d, 0x7e, 0x23, 0xd8, 0x21,
+	0x2d, 0x6e, 0x9a, 0x1d, 0x0f, 0xa0, 0x12, 0x5c, 0xc5, 0x83, 0x60, 0xac, 0x38, 0xd2, 0xe0, 0x60,
+	0xf6, 0xc5, 0x98, 0xa3, 0x26, 0xb5, 0xb4, 0x8b, 0xb9, 0xf6, 0x2e, 0x82, 0xad, 0x92, 0x19, 0x6c,
+	0xc5, 0xd0, 0x90, 0x07, 0x4f, 0x02, 0x3f, 0xa2, 0x0c, 0xdf, 0x90, 0x23, 0x91, 0xc8, 0x3a, 0x41,
+	0xcc, 0x51, 0x73, 0x79, 0x64, 0xcd, 0x57, 0x89, 0xa2, 0xc9, 0x15, 0xfc, 0x0f, 0x16, 0x40, 0x12,
+	0xaa, 0x4c, 0x59, 0xb9, 0x21, 0xd8, 0x42, 0x4a, 0xb0, 0x2a, 0xcd, 0x54, 0x34, 0xd2, 0x4c, 0x1f,
+	0x43, 0x63, 0x10, 0xf8, 0x31, 0xf5, 0xe3, 0x13, 0xae, 0x31, 0x82, 0xbc, 0xba, 0x1c, 0x63, 0xea,
+	0xc2, 0xb6, 0x45, 0xde, 0x1f, 0xaa, 0x94, 0x05, 0x6f, 0x33, 0xd4, 0xa2, 0x0b, 0x77, 0xf3, 0x8b,
+	0x2f, 0xb9, 0xa0, 0x6b, 0x8e, 0xec, 0x99, 0x46, 0x51, 0x49, 0x1b, 0xc5, 0x9f, 0x5a, 0xb0, 0x98,
+	0x20, 0x2d, 0x72, 0x42, 0x33, 0x5f, 0x2f, 0x79, 0xc9, 0xbd, 0x2c, 0xa6, 0xc5, 0xd9, 0x98, 0x96,
+	0x0c, 0x4c, 0x55, 0xde, 0x69, 0xc1, 0xc8, 0x3b, 0x7d, 0x06, 0xf7, 0x12, 0x54, 0xb6, 0x83, 0x37,
+	0xfe, 0x28, 0x70, 0x87, 0xb3, 0x6e, 0xbc, 0x7f, 0xb2, 0xa0, 0x7a, 0x24, 0xb3, 0x0b, 0x3f, 0x35,
+	0xfa, 0x9d, 0x62, 0xbb, 0x32, 0xa0, 0x52, 0xfa, 0xe5, 0xcb, 0x53, 0xa1, 0x2c, 0xed, 0xc0, 0x93,
+	0x54, 0xa2, 0x67, 0xf2, 0xb4, 0x3c, 0x3b, 0x52, 0xae, 0xa4, 0x23, 0x65, 0xfc, 0x1b, 0x58, 0x55,
+	0x58, 0x4f, 0x25, 0x60, 0xa6, 0x92, 0x8d, 0x39, 0x99, 0x04, 0xfc, 0x69, 0x02, 0x60, 0xfe, 0x7b,
+	0xf4, 0x2b, 0x68, 0xa8, 0x85, 0xdc, 0xe1, 0x7d, 0x0a, 0x35, 0x95, 0x8d, 0x49, 0x6e, 0x77, 0xb5,
+	0xc2, 0x49, 0xe6, 0xf0, 0x4b, 0x68, 0x6e, 0x05, 0x63, 0x26, 0x83, 0xae, 0x3f, 0xb8, 0x08, 0x42,
+	0x33, 0x60, 0xb7, 0xd2, 0x01, 0xfb, 0x0a, 0x2c, 0x44, 0xb1, 0x1b, 0xea, 0x5b, 0x80, 0x77, 0x98,
+	0xa5, 0x53, 0x5f, 0x5d, 0x30, 0xac, 0x89, 0xff, 0xdb, 0x82, 0x8a, 0x84, 0x79, 0x7b, 0xbb, 0x78,
+	0x0f, 0x6a, 0x13, 0x37, 0xa4, 0xe2, 0x56, 0xd4, 0x59, 0x55, 0x36, 0x90, 0x7e, 0x48, 0x97, 0xde,
+	0xf5, 0xbe, 0x31, 0xdd, 0xe1, 0x03, 0x28, 0xbb, 0x9c, 0x2a, 0x2e, 0x34, 0x76, 0x73, 0xa7, 0x68,
+	0x75, 0xca, 0xae, 0xa6, 0x39, 0xdf, 0x62, 0x52, 0xd2, 0xad, 0x66, 0xde, 0x41, 0x6d, 0xa8, 0x0c,
+	0xb9, 0x50, 0x86, 0xfc, 0x4d, 0x52, 0x75, 0x54, 0x17, 0xff, 0x89, 0x05, 0x2b, 0xf2, 0xa4, 0xb4,
+	0xdc, 0x67, 0x1a, 0x5b, 0x8a, 0xfc, 0x42, 0x86, 0xfc, 0xbc, 0xa7, 0x5b, 0x42, 0x5a, 0x69, 0x1e,
+	0x69, 0xf8, 0xb1, 0xc6, 0xe4, 0x47, 0xe7, 0xb1, 0xf0, 0x03, 0xbd, 0x77, 0xbe, 0xf2, 0xfd, 0x02,
+	0x90, 0x5c, 0x67, 0xe6, 0x23, 0x67, 0xd1, 0x8a, 0x1f, 0x41, 0x5d, 0x2e, 0xe7, 0xaa, 0x7a, 0x1f,
+	0xaa, 0x03, 0xd9, 0x95, 0x9a, 0x5a, 0x55, 0xb4, 0x38, 0x7a, 0x06, 0xff, 0x55, 0x11, 0x10, 0x7b,
+	0xb7, 0x87, 0xbc, 0x8e, 0xb1, 0x4d, 0x07, 0x1e, 0xd7, 0xc9, 0x5b, 0xeb, 0x97, 0xa1, 0x42, 0xc5,
+	0x59, 0x2a, 0xf4, 0x73, 0x28, 0xbb, 0x83, 0x58, 0x5d, 0xb7, 0xad, 0xcd, 0x25, 0x92, 0x9c, 0xd8,
+	0xe5, 0x13, 0x8e, 0x5c, 0xc0, 0x35, 0xe6, 0x82, 0x0e, 0x2e, 0x69, 0x28, 0x15, 0x4e, 0x75, 0x99,
+	0x07, 0x09, 0xa9, 0x1b, 0x05, 0xbe, 0xf2, 0xca, 0xa2, 0xa7, 0x19, 0x5c, 0xc9, 0x7f, 0x7f, 0x57,
+	0xd3, 0x7a, 0x97, 0x3c, 0x25, 0x6a, 0xb7, 0x7d, 0x4a, 0x40, 0xf6, 0x5d, 0xfb, 0x90, 0x5b, 0xf2,
+	0xd0, 0x1b, 0x88, 0x37, 0x2f, 0x8b, 0x41, 0x1c, 0xca, 0x62, 0xe4, 0x57, 0x62, 0xd4, 0x51, 0xd3,
+	0xec, 0x89, 0x1b, 0xf2, 0x19, 0x1a, 0x32, 0xce, 0x35, 0xc4, 0x13, 0x57, 0x0d, 0xf5, 0xb9, 0x19,
+	0xc8, 0xde, 0x90, 0x3f, 0x80, 0x8b, 0x8e, 0xee, 0xb3, 0x6c, 0x78, 0xc2, 0xa6, 0xdb, 0x28, 0x00,
+	0x23, 0x7b, 0x42, 0xfd, 0xa1, 0xe7, 0x9f, 0xcb, 0x1c, 0x87, 0xea, 0xe2, 0x43, 0xb8, 0x9b, 0xc0,
+	0x12, 0xc8, 0xce, 0x52, 0x58, 0x83, 0xba, 0xc2, 0x5c, 0xea, 0xf0, 0x37, 0xf2, 0xe5, 0x36, 0x72,
+	0xcf, 0x67, 0x01, 0x4b, 0x84, 0x56, 0x30, 0x85, 0x86, 0x7f, 0x0f, 0xd6, 0xa6, 0x95, 0x8e, 0x6b,
+	0xed, 0xff, 0x87, 0xda, 0x50, 0xf5, 0xa5, 0xda, 0x2e, 0x93, 0xe9, 0xb5, 0x4e, 0xb2, 0x0a, 0xff,
+	0x9d, 0x05, 0x95, 0xd7, 0xf4, 0xf4, 0x22, 0x08, 0x2e, 0x7f, 0xd2, 0x1d, 0x66, 0x43, 0xf1, 0x2a,
+	0x54, 0x75, 0x35, 0xd6, 0x64, 0x4e, 0x80, 0x5e, 0x73, 0xc3, 0x61, 0x29, 0xf6, 0xe9, 0x20, 0x53,
+	0xce, 0xf2, 0x48, 0x81, 0x0e, 0x42, 0xaa, 0xbc, 0xa3, 0xec, 0xcd, 0xbe, 0xd5, 0xf0, 0x05, 0xac,
+	0x48, 0x54, 0xd3, 0x0e, 0x4c, 0xe2, 0x60, 0xe5, 0xe1, 0x50, 0xb8, 0x25, 0x0e, 0x45, 0x13, 0x07,
+	0xfc, 0x40, 0x9f, 0x34, 0xdf, 0xc9, 0x3c, 0x82, 0xba, 0x5c, 0xa7, 0xbc, 0xc6, 0x1b, 0xd9, 0xd5,
+	0x5e, 0x43, 0xce, 0x3b, 0x7a, 0x06, 0xff, 0x67, 0x01, 0x16, 0x13, 0xe8, 0xde, 0x35, 0x0d, 0x6f,
+	0xf2, 0x1e, 0x64, 0x72, 0xbd, 0xf1, 0x20, 0x93, 0x23, 0xfd, 0x21, 0x4b, 0xdc, 0x71, 0x0a, 0x64,
+	0x46, 0x25, 0x4b, 0x9e, 0x98, 0xe4, 0x2a, 0xed, 0xde, 0xb0, 0x10, 0x46, 0x86, 0x13, 0xaa, 0x8b,
+	0x3e, 0xcd, 0x14, 0x32, 0x16, 0x89, 0xc2, 0x24, 0x63, 0xcd, 0x2c, 0x13, 0x1c, 0xb3, 0x8b, 0x39,
+	0x56, 0x55, 0x27, 0xdd, 0x67, 0x71, 0x97, 0xcf, 0x6a, 0x52, 0x72, 0x40, 0xde, 0x52, 0x75, 0x36,
+	0xd6, 0x15, 0x43, 0xe8, 0x13, 0x68, 0x86, 0x32, 0x04, 0x3e, 0xe1, 0x69, 0xca, 0x2a, 0x4f, 0x53,
+	0x36, 0xd4, 0xe0, 0x56, 0x2a, 0x5d, 0x59, 0x33, 0x22, 0x68, 0x53, 0x0d, 0x60, 0xf6, 0xf5, 0x57,
+	0xcf, 0x04, 0x37, 0xfb, 0xd0, 0x4e, 0xb3, 0xd6, 0xa3, 0x91, 0x51, 0x08, 0x33, 0x78, 0x6a, 0x65,
+	0x79, 0x9a, 0xff, 0x9e, 0x7c, 0x06, 0x2b, 0x53, 0x00, 0x99, 0xa8, 0x3f, 0x07, 0x18, 0xea, 0x01,
+	0x29, 0x6c, 0x9b, 0x64, 0xc4, 0xea, 0x18, 0x6b, 0xf0, 0xbf, 0x59, 0x50, 0xdd, 0x0b, 0x62, 0x7a,
+	0xfa, 0x53, 0x4d, 0x2d, 0x2f, 0x5c, 0x4c, 0x5d, 0xd1, 0xa5, 0xcc, 0x15, 0xcd, 0xaa, 0xb8, 0x41,
+	0xe4, 0xf1, 0xcb, 0x43, 0xe6, 0x4b, 0x55, 0xff, 0x27, 0xc6, 0x8e, 0xcf, 0x60, 0x55, 0x91, 0xf0,
+	0xee, 0xd8, 0x71, 0x5e, 0xf8, 0x80, 0x0f, 0x60, 0x59, 0x41, 0x32, 0xdd, 0x73, 0x6a, 0x8f, 0x95,
+	0xa1, 0xe7, 0x7d, 0xa8, 0x85, 0x94, 0x3d, 0x75, 0xbc, 0x6b, 0xaa, 0x12, 0xd1, 0x7a, 0x00, 0xff,
+	0x2a, 0xc1, 0xcd, 0xa1, 0x0c, 0x81, 0x39, 0x51, 0x45, 0xf6, 0x0d, 0x81, 0xbf, 0x4f, 0xd0, 0x79,
+	0x11, 0x5c, 0xcf, 0xdc, 0x3a, 0x37, 0x22, 0x32, 0xd9, 0x5d, 0x4c, 0xb3, 0x9b, 0xc5, 0xcc, 0x0a,
+	0xfe, 0x7c, 0x8f, 0xb2, 0x0d, 0x2b, 0x6a, 0x21, 0x4f, 0xdf, 0xcc, 0xc2, 0x64, 0x3e, 0x2f, 0xbe,
+	0x82, 0x86, 0x82, 0xa2, 0x22, 0x6f, 0x5f, 0xf5, 0x75, 0xe4, 0xad, 0xb9, 0x95, 0xcc, 0xe1, 0xef,
+	0xc5, 0xb5, 0x34, 0x8f, 0x07, 0x1f, 0x42, 0x5d, 0xad, 0x4f, 0xb8, 0x00, 0x6a, 0x68, 0x3e, 0x1f,
+	0xd6, 0x3f, 0x86, 0x66, 0xea, 0x57, 0x00, 0xaa, 0x40, 0xf1, 0xbb, 0xfe, 0x81, 0x7d, 0x87, 0x35,
+	0x8e, 0xba, 0x8e, 0x6d, 0xad, 0x3f, 0x02, 0x48, 0x72, 0x03, 0xa8, 0x0e, 0x95, 0x03, 0xa7, 0xff,
+	0xaa, 0x7b, 0xd4, 0xb3, 0xef, 0xa0, 0x06, 0x54, 0x8f, 0xf7, 0x76, 0xfb, 0x87, 0x47, 0xbd, 0x6d,
+	0xdb, 0x42, 0x00, 0xe5, 0x83, 0xe3, 0x27, 0xbb, 0xfd, 0x2d, 0xbb, 0xb0, 0xbe, 0x23, 0xb2, 0x3e,
+	0xc2, 0x7b, 0xa1, 0x26, 0xd4, 0xf8, 0xcc, 0xe1, 0xb3, 0xde, 0xb6, 0x7d, 0x07, 0xd5, 0x60, 0x61,
+	0xdb, 0xe9, 0xee, 0x1c, 0xd9, 0x16, 0x9b, 0x39, 0xdc, 0x7a, 0xd6, 0xdb, 0x3e, 0xde, 0xed, 0x6d,
+	0xdb, 0x05, 0xb4, 0x08, 0xf5, 0x97, 0xc7, 0x5d, 0xa7, 0xbb, 0x77, 0xd4, 0xdf, 0xeb, 0x6d, 0xdb,
+	0xc5, 0xf5, 0x47, 0x50, 0x62, 0x59, 0x5e, 0x54, 0x85, 0xd2, 0xde, 0xfe, 0x1e, 0x3b, 0x13, 0xa0,
+	0xfc, 0xaa, 0xdf, 0x7b, 0xdd, 0x73, 0xc4, 0x89, 0xbd, 0xed, 0xfe, 0xd1, 0xbe, 0x63, 0x17, 0x18,
+	0xd0, 0xfd, 0xd7, 0x7b, 0x3d, 0xc7, 0x2e, 0xae, 0xdf, 0x07, 0x48, 0x2a, 0x9b, 0x6c, 0x51, 0x7f,
+	0xef, 0xb0, 0xe7, 0x1c, 0x89, 0xcd, 0xdb, 0xbd, 0xdd, 0xde, 0x51, 0xcf, 0xb6, 0xd6, 0x1f, 0x42,
+	0x4d, 0x17, 0x7a, 0xd8, 0x44, 0xf7, 0x68, 0xff, 0x45, 0x7f, 0xcb, 0xbe, 0xc3, 0x90, 0x78, 0xd2,
+	0x3b, 0x3c, 0x3a, 0xe9, 0xed, 0xec, 0xec, 0x3b, 0x47, 0xb6, 0xb5, 0xfe, 0x25, 0x34, 0x53, 0xfe,
+	0x9c, 0x31, 0x61, 0xcb, 0xe9, 0x75, 0x8f, 0x38, 0x35, 0x75, 0xa8, 0x1c, 0x1f, 0x6c, 0x77, 0x05,
+	0x0f, 0xea, 0x50, 0x11, 0x07, 0x6c, 0xdb, 0x85, 0xf5, 0x6f, 0xa1, 0x6e, 0x64, 0x2f, 0xd8, 0x5c,
+	0xf7, 0xe0, 0x60, 0xb7, 0xcf, 0x77, 0x01, 0x94, 0x5f, 0xf4, 0x9c, 0xa7, 0x8a, 0x71, 0x5b, 0xfb,
+	0x07, 0x7d, 0xce, 0x81, 0x06, 0x54, 0x9d, 0xde, 0xf3, 0xde, 0xd6, 0x11, 0x27, 0xff, 0x1b, 0xb0,
+	0xb3, 0xd1, 0x25, 0x23, 0xb4, 0xbb, 0xbb, 0xbb, 0xff, 0xda, 0xbe, 0x83, 0x5a, 0x00, 0x09, 0xbb,
+	0x04, 0x20, 0xb1, 0xd9, 0x2e, 0xac, 0xff, 0x12, 0x9a, 0xa9, 0x50, 0x87, 0x4b, 0xae, 0xb7, 0xb7,
+	0xdd, 0xdf, 0x7b, 0x6a, 0xdf, 0x61, 0xfc, 0x3c, 0x3c, 0xe8, 0xbe, 0xb0, 0x2d, 0x76, 0xe0, 0xde,
+	0xfe, 0xd1, 0x09, 0xef, 0x15, 0xd6, 0xbf, 0x82, 0x56, 0xfa, 0xe6, 0x61, 0x30, 0x5f, 0x1e, 0xf7,
+	0x8e, 0x39, 0xd2, 0x4d, 0xa8, 0x6d, 0xf7, 0x76, 0xfb, 0xaf, 0x7a, 0x8e, 0xc2, 0x7b, 0xa7, 0xdb,
+	0xe7, 0x92, 0xdb, 0xfc, 0x6d, 0x11, 0xaa, 0xd2, 0x43, 0x46, 0x68, 0x1b, 0xaa, 0xea, 0xe7, 0x0c,
+	0xb2, 0x49, 0xe6, 0x13, 0x4d, 0xa7, 0x4a, 0xe4, 0xdf, 0x1c, 0xfc, 0xfe, 0x6f, 0xff, 0xe3, 0xbf,
+	0xfe, 0xbc, 0xb0, 0x86, 0x97, 0x36, 0xa4, 0x4f, 0x25, 0xa1, 0x5c, 0xfb, 0xd8, 0x5a, 0x47, 0x5d,
+	0xa8, 0xc8, 0x6f, 0x32, 0x68, 0x91, 0xa4, 0x3f, 0xcc, 0x18, 0x30, 0xde, 0xe3, 0x30, 0x56, 0xb1,
+	0xad, 0x61, 0x0c, 0xc4, 0x52, 0x06, 0xe2, 0x1b, 0x68, 0xa6, 0xfe, 0xc6, 0xa0, 0x55, 0x92, 0xf7,
+	0x57, 0xa6, 0xd3, 0x24, 0xe6, 0x17, 0x18, 0x7c, 0xe7, 0x73, 0x0b, 0x7d, 0x0d, 0xcd, 0xd4, 0x97,
+	0x17, 0x94, 0x5e, 0xd3, 0x59, 0x21, 0x39, 0x3f, 0x62, 0xf0, 0x9d, 0x87, 0x16, 0x5a, 0x87, 0x2a,
+	0xff, 0xaa, 0xf2, 0x94, 0xc6, 0xa8, 0x4c, 0xf8, 0x07, 0xa9, 0x4e, 0x99, 0xf0, 0x21, 0xdc, 0xe2,
+	0xd8, 0x56, 0x51, 0x79, 0xe3, 0x07, 0xd6, 0x47, 0xbb, 0xd0, 0x4a, 0xff, 0x12, 0x41, 0x6b, 0x24,
+	0xf7, 0xdb, 0x48, 0x47, 0x5f, 0x40, 0xb8, 0xcd, 0x61, 0x20, 0xdc, 0xd4, 0x14, 0xb3, 0x4f, 0x22,
+	0x8f, 0xad, 0xf5, 0xcd, 0x7f, 0xb4, 0x61, 0x41, 0x7c, 0x6f, 0xf9, 0x56, 0x66, 0x5d, 0xf9, 0xa5,
+	0x80, 0x72, 0x6a, 0xb5, 0x1d, 0x91, 0x34, 0xc3, 0x77, 0x39, 0xb0, 0x25, 0xdc, 0xd8, 0x60, 0x71,
+	0x37, 0x11, 0x97, 0x0e, 0x63, 0xdd, 0xa1, 0x80, 0x20, 0x1e, 0x84, 0x28, 0xa7, 0x12, 0xab, 0x20,
+	0xac, 0x73, 0x08, 0xf7, 0x15, 0x04, 0xf1, 0xf1, 0xe0, 0xb1, 0xb5, 0xfe, 0xdd, 0xd2, 0x66, 0x76,
+	0x08, 0xfd, 0x2e, 0xd4, 0xf4, 0xdf, 0x00, 0xb4, 0x44, 0xb2, 0xff, 0x04, 0x14, 0xc8, 0x35, 0x0e,
+	0xd2, 0xc6, 0x75, 0xb1, 0x7f, 0xc2, 0x96, 0xb0, 0xed, 0xbb, 0x60, 0x67, 0x8b, 0xfc, 0xa8, 0x4d,
+	0x66, 0xd4, 0xfd, 0x67, 0x50, 0x28, 0x02, 0x29, 0x06, 0x4d, 0xf2, 0x48, 0xf8, 0x7f, 0x49, 0x61,
+	0xea, 0x32, 0x98, 0x01, 0x41, 0x3c, 0xe0, 0x19, 0x84, 0x5e, 0x52, 0x61, 0xef, 0x8a, 0x7a, 0x3c,
+	0xb2, 0x49, 0xa6, 0xe6, 0xde, 0x49, 0x6a, 0x85, 0x78, 0x95, 0x03, 0x5a, 0xc4, 0x20, 0x00, 0xb1,
+	0xca, 0x21, 0x03, 0xd3, 0x87, 0xa6, 0x5a, 0xe2, 0x50, 0x9f, 0xbe, 0x99, 0x0f, 0x24, 0x51, 0x78,
+	0x0d, 0x84, 0x84, 0x6c, 0x9b, 0x00, 0x65, 0xd4, 0xfc, 0x47, 0xd4, 0x8d, 0xf2, 0x30, 0x92, 0x64,
+	0x7d, 0xc0, 0x01, 0xdd, 0xc5, 0x28, 0x05, 0x88, 0x6f, 0x62, 0xa0, 0xbe, 0x33, 0xea, 0xd3, 0x52,
+	0x8f, 0xee, 0x92, 0xfc, 0xbf, 0x01, 0x1d, 0x9b, 0x64, 0x4a, 0xd9, 0x86, 0x69, 0x73, 0xe0, 0xa7,
+	0xc9, 0x9e, 0x2c, 0x6c, 0x29, 0xc7, 0xbb, 0x24, 0x33, 0xf2, 0xe3, 0x60, 0x1f, 0x4f, 0x86, 0x39,
+	0xb0, 0xa5, 0x6c, 0xef, 0x92, 0xcc, 0xc8, 0x8f, 0x83, 0xbd, 0xad, 0x05, 0xfe, 0x4b, 0xa8, 0xc8,
+	0x8f, 0x58, 0x68, 0x91, 0xa4, 0xbf, 0x64, 0x29, 0xae, 0x2e, 0x71, 0x00, 0x75, 0x54, 0x13, 0x00,
+	0xce, 0x69, 0x8c, 0x74, 0x29, 0x8e, 0xbd, 0x8d, 0x48, 0xe6, 0x4b, 0x96, 0x14, 0x2d, 0x0b, 0x0b,
+	0x0c, 0xef, 0x20, 0x6a, 0xc1, 0x7d, 0xa8, 0xe9, 0x0a, 0xaf, 0x34, 0x17, 0xb3, 0xda, 0xdb, 0x59,
+	0x22, 0xd9, 0xd2, 0x66, 0xd6, 0x74, 0x78, 0x15, 0x97, 0x61, 0xbe, 0x0f, 0x75, 0xa3, 0xae, 0x8b,
+	0x96, 0xc9, 0x74, 0x95, 0x37, 0x0f, 0x5c, 0xe2, 0x6b, 0x84, 0x25, 0xfb, 0x1a, 0xe0, 0xf7, 0xf2,
+	0xcf, 0x98, 0xb9, 0x03, 0xdd, 0x23, 0xb3, 0x0a, 0xc0, 0x79, 0xc0, 0xa5, 0x26, 0xa3, 0x65, 0xe9,
+	0x7b, 0x52, 0xa0, 0x9e, 0xab, 0x4f, 0x17, 0x83, 0x4b, 0x5e, 0x66, 0x44, 0x4b, 0xba, 0xf0, 0x18,
+	0x25, 0x6e, 0xdb, 0xac, 0x54, 0x2a, 0x3b, 0x45, 0x8b, 0x4a, 0x76, 0x6a, 0xeb, 0x33, 0x51, 0xd2,
+	0xdc, 0xbf, 0x8a, 0x6f, 0x0b, 0x4a, 0xb2, 0x11, 0xb5, 0x04, 0xa8, 0x40, 0xed, 0x7c, 0x0c, 0x55,
+	0x55, 0x79, 0x95, 0xa2, 0x34, 0x6a, 0xbd, 0x4a, 0x05, 0x32, 0x66, 0xce, 0x7e, 0x01, 0x30, 0x8e,
+	0xfd, 0x1a, 0x6a, 0x6a, 0x83, 0x42, 0xc1, 0xac, 0xe0, 0x9a, 0x8a, 0xb0, 0xcc, 0x21, 0x34, 0x51,
+	0x3d, 0x81, 0x10, 0xa1, 0x1e, 0xd4, 0x74, 0xcd, 0x52, 0x69, 0x83, 0x51, 0x73, 0xed, 0xd8, 0xe6,
+	0x10, 0x57, 0xe6, 0x0c, 0x98, 0x88, 0xef, 0xec, 0x42, 0x4d, 0xd7, 0x1f, 0x25, 0x18, 0xb3, 0x16,
+	0xd9, 0x81, 0xe4, 0xf1, 0x9a, 0x05, 0xf0, 0x86, 0xad, 0xfb, 0xdc, 0x42, 0x5b, 0xd0, 0x30, 0x2b,
+	0x83, 0x68, 0x85, 0xe4, 0x14, 0x0a, 0x3b, 0x75, 0x3d, 0x4a, 0x63, 0x6c, 0x73, 0x48, 0x80, 0xaa,
+	0x1b, 0xaa, 0x68, 0xf2, 0x73, 0x28, 0xb1, 0xe8, 0x08, 0x35, 0x88, 0x51, 0x60, 0xea, 0x34, 0x89,
+	0x59, 0xa7, 0x61, 0xf7, 0xe9, 0xe7, 0x16, 0xfa, 0x02, 0xec, 0xa4, 0x0c, 0x70, 0x3c, 0xe1, 0x4f,
+	0x66, 0x9b, 0x64, 0x8a, 0x14, 0x1d, 0xf3, 0x5b, 0x08, 0xdb, 0x88, 0x76, 0x00, 0x4d, 0x57, 0x0f,
+	0x50, 0x87, 0xcc, 0x2c, 0x29, 0x74, 0xa6, 0x80, 0xf2, 0x50, 0xe0, 0x00, 0x5a, 0xe9, 0x0c, 0x3d,
+	0x5a, 0x23, 0xb9, 0x29, 0xfb, 0x4e, 0x92, 0x3e, 0x37, 0xdc, 0xb4, 0xca, 0xa3, 0x1b, 0x97, 0xeb,
+	0x37, 0x49, 0x26, 0x9e, 0x7b, 0x05, 0x15, 0x26, 0x34, 0x89, 0x99, 0xa0, 0xc7, 0x88, 0xc3, 0x68,
+	0x20, 0xd0, 0x30, 0x22, 0x13, 0x19, 0xe9, 0xdd, 0xd6, 0x48, 0x7a, 0xe0, 0x76, 0xc8, 0xe8, 0x5b,
+	0x6c, 0xf3, 0x5f, 0x0a, 0x50, 0x55, 0xb9, 0x56, 0xb4, 0xab, 0x53, 0xfd, 0x92, 0xd4, 0x55, 0x92,
+	0x97, 0xa4, 0xee, 0xe8, 0xf4, 0x2b, 0xee, 0x70, 0xd8, 0x2b, 0x78, 0x71, 0x43, 0xe6, 0x61, 0x0d,
+	0x3a, 0x13, 0x68, 0xd2, 0xcb, 0xaf, 0x92, 0x54, 0xff, 0x36, 0xd0, 0x92, 0xe8, 0x21, 0x81, 0x26,
+	0x29, 0x5f, 0x25, 0xa9, 0xfe, 0x6d, 0xa0, 0x25, 0x97, 0xf7, 0x53, 0x9d, 0x61, 0xe6, 0x22, 0x58,
+	0x26, 0xd3, 0xe9, 0xe9, 0x4e, 0x83, 0x18, 0x49, 0x68, 0x65, 0xd7, 0xa8, 0xa9, 0xa1, 0x8d, 0xbc,
+	0x28, 0xde, 0xfc, 0xcb, 0x02, 0x40, 0x12, 0xa5, 0xa3, 0xdf, 0x87, 0x56, 0x3a, 0xd5, 0x89, 0xd6,
+	0x48, 0x6e, 0xee, 0xb3, 0x73, 0x97, 0xe4, 0xe7, 0x0d, 0x95, 0xd7, 0x45, 0xf6, 0xc6, 0x58, 0x2f,
+	0xe0, 0x67, 0xa1, 0x3f, 0x30, 0x1f, 0x04, 0x22, 0xbe, 0x47, 0x6d, 0x32, 0x23, 0x1d, 0xda, 0xc9,
+	0x4b, 0x36, 0x1a, 0xd7, 0xbe, 0x01, 0x5c, 0x24, 0x6a, 0x19, 0x5b, 0x9e, 0x49, 0x0f, 0x37, 0x72,
+	0xcf, 0x95, 0x87, 0x4b, 0x72, 0xa2, 0xf9, 0x10, 0xb3, 0xfe, 0x6e, 0xe4, 0x9e, 0x33, 0xbd, 0xfa,
+	0xf7, 0x02, 0x54, 0x55, 0x36, 0x8e, 0xc9, 0x2e, 0x95, 0x2b, 0x44, 0xab, 0x24, 0x2f, 0x77, 0xd8,
+	0xd1, 0x09, 0x3a, 0x43, 0x76, 0x32, 0x27, 0x64, 0xe8, 0xd5, 0x97, 0x3a, 0xcf, 0x97, 0x32, 0x9f,
+	0x06, 0x31, 0xb2, 0x7f, 0xc6, 0x4d, 0xfc, 0x66, 0x1a, 0x0b, 0xad, 0x41, 0x79, 0x79, 0xc5, 0xb9,
+	0x58, 0x24, 0x1a, 0x74, 0x0a, 0x4b, 0x53, 0xb9, 0x28, 0x74, 0x8f, 0xcc, 0x4a, 0x78, 0x75, 0x56,
+	0x49, 0x5e, 0xea, 0xca, 0xb8, 0x06, 0x8d, 0x23, 0xe4, 0xfc, 0xe6, 0xdf, 0x94, 0xa0, 0xa6, 0x53,
+	0x07, 0xcc, 0xf8, 0xd3, 0xf9, 0x1e, 0xb4, 0x46, 0x72, 0x13, 0x40, 0x9d, 0x24, 0x9d, 0x60, 0x18,
+	0xbf, 0x4a, 0x0a, 0x18, 0x9c, 0x7c, 0x9a, 0x64, 0x26, 0x38, 0x2b, 0x57, 0x48, 0x4e, 0x1a, 0xa8,
+	0xd3, 0x24, 0x66, 0xfa, 0xc2, 0xf0, 0x4b, 0x7e, 0x1e, 0x6a, 0x22, 0xdd, 0x63, 0xa0, 0x96, 0xca,
+	0xff, 0xbc, 0x03, 0xb5, 0x90, 0xaf, 0x65, 0xa8, 0x3d, 0x4f, 0x50, 0x63, 0xf9, 0x0f, 0x03, 0x35,
+	0x23, 0x1d, 0x62, 0x42, 0xbb, 0xc7, 0xa1, 0x2d, 0xe3, 0x56, 0x02, 0x6d, 0x1c, 0x5c, 0x73, 0x58,
+	0x06, 0x76, 0xda, 0x6b, 0xe6, 0x26, 0x80, 0xde, 0x81, 0x5d, 0x22, 0xfc, 0xe7, 0xd0, 0x4c, 0x25,
+	0x86, 0xd0, 0x2a, 0xc9, 0x4b, 0x14, 0x99, 0xb7, 0x7a, 0x12, 0x9f, 0x68, 0x78, 0x22, 0xce, 0x93,
+	0x51, 0x05, 0xa7, 0xd2, 0x26, 0xaa, 0x39, 0x3f, 0xaa, 0x90, 0x94, 0x9d, 0x96, 0xf9, 0xef, 0xef,
+	0x47, 0xff, 0x33, 0x00, 0x5d, 0xef, 0x22, 0x56, 0xd9, 0x33, 0x00, 0x00,
 }
@@ -0,0 +1,305 @@
+// Package patch applies text edits to pages and three-way merges concurrent
+// edits of the same page.
+package patch
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrOutOfRange means an edit referred to text past the end of the document.
+	ErrOutOfRange = errors.New("Edit is out of range")
+
+	// ErrMalformedDiff means a unified diff couldn't be parsed.
+	ErrMalformedDiff = errors.New("Malformed diff")
+
+	// ErrDiffMismatch means a diff hunk didn't match the text it was applied to.
+	ErrDiffMismatch = errors.New("Diff does not apply to text")
+
+	// ErrConflict means both sides of a merge changed the same lines
+	// differently, or the edits were too large to compare.
+	ErrConflict = errors.New("Merge conflict")
+)
+
+// maxDiffCells bounds the lines compared when diffing, counted as the
+// product of the changed lines on each side, so merging large edits can't
+// exhaust memory.
+const maxDiffCells = 1 << 22
+
+// Splice replaces n code points of text at offset with insert.
+func Splice(text []rune, offset, n int, insert string) ([]rune, error) {
+	if offset < 0 || n < 0 || offset > len(text) || n > len(text)-offset {
+		return nil, ErrOutOfRange
+	}
+	ins := []rune(insert)
+	out := make([]rune, 0, len(text)-n+len(ins))
+	out = append(out, text[:offset]...)
+	out = append(out, ins...)
+	return append(out, text[offset+n:]...), nil
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// Apply applies the hunks of a unified diff to text. File headers are
+// ignored and hunks must match the text exactly; no fuzz is applied.
+func Apply(text, diff string) (string, error) {
+	lines := splitLines(text)
+	var out []string
+	pos := 0
+	seen := false
+	body := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i := 0; i < len(body); {
+		m := hunkHeader.FindStringSubmatch(body[i])
+		if m == nil {
+			if !seen {
+				// Skip file headers preceding the first hunk.
+				i++
+				continue
+			}
+			return "", ErrMalformedDiff
+		}
+		start, err := strconv.Atoi(m[1])
+		if err != nil {
+			return "", ErrMalformedDiff
+		}
+		count := 1
+		if m[2] != "" {
+			if count, err = strconv.Atoi(m[2]); err != nil {
+				return "", ErrMalformedDiff
+			}
+		}
+		seen = true
+		i++
+
+		var before, after []string
+		var last byte
+		for ; i < len(body) && !strings.HasPrefix(body[i], "@@"); i++ {
+			line := body[i]
+			if line == "" {
+				// Some editors strip the leading space from empty context lines.
+				line = " "
+			}
+			switch line[0] {
+			case ' ':
+				before = append(before, line[1:]+"\n")
+				after = append(after, line[1:]+"\n")
+			case '-':
+				before = append(before, line[1:]+"\n")
+			case '+':
+				after = append(after, line[1:]+"\n")
+			case '\\':
+				// "\ No newline at end of file" applies to the previous line.
+				if last == ' ' || last == '-' {
+					before[len(before)-1] = strings.TrimSuffix(before[len(before)-1], "\n")
+				}
+				if last == ' ' || last == '+' {
+					after[len(after)-1] = strings.TrimSuffix(after[len(after)-1], "\n")
+				}
+				if last == 0 {
+					return "", ErrMalformedDiff
+				}
+			default:
+				return "", ErrMalformedDiff
+			}
+			last = line[0]
+		}
+		if len(before) != count {
+			return "", ErrMalformedDiff
+		}
+
+		// Line numbers are 1-based, except that an empty old range names
+		// the line the insertion follows.
+		at := start - 1
+		if count == 0 {
+			at = start
+		}
+		if at < pos || at > len(lines) || len(before) > len(lines)-at {
+			return "", ErrDiffMismatch
+		}
+		if !equal(lines[at:at+len(before)], before) {
+			return "", ErrDiffMismatch
+		}
+		out = append(out, lines[pos:at]...)
+		out = append(out, after...)
+		pos = at + len(before)
+	}
+	out = append(out, lines[pos:]...)
+	return strings.Join(out, ""), nil
+}
+
+// Merge combines two independent edits, ours and theirs, of a common base.
+// Changes are compared line by line; when both sides change the same lines
+// differently ErrConflict is returned.
+func Merge(base, ours, theirs string) (string, error) {
+	switch {
+	case ours == theirs, base == theirs:
+		return ours, nil
+	case base == ours:
+		return theirs, nil
+	}
+	orig := splitLines(base)
+	a, ok := diff(orig, splitLines(ours))
+	if !ok {
+		return "", ErrConflict
+	}
+	b, ok := diff(orig, splitLines(theirs))
+	if !ok {
+		return "", ErrConflict
+	}
+
+	var out []string
+	pos := 0
+	for len(a) > 0 || len(b) > 0 {
+		// Gather every hunk from either side overlapping the earliest one.
+		var ga, gb []hunk
+		var start, end int
+		if len(b) == 0 || (len(a) > 0 && a[0].start <= b[0].start) {
+			start, end = a[0].start, a[0].end
+		} else {
+			start, end = b[0].start, b[0].end
+		}
+		for {
+			if len(a) > 0 && a[0].start <= end {
+				ga, a = append(ga, a[0]), a[1:]
+				end = maxInt(end, ga[len(ga)-1].end)
+				continue
+			}
+			if len(b) > 0 && b[0].start <= end {
+				gb, b = append(gb, b[0]), b[1:]
+				end = maxInt(end, gb[len(gb)-1].end)
+				continue
+			}
+			break
+		}
+
+		ra := replace(orig, start, end, ga)
+		rb := replace(orig, start, end, gb)
+		out = append(out, orig[pos:start]...)
+		switch {
+		case len(gb) == 0:
+			out = append(out, ra...)
+		case len(ga) == 0:
+			out = append(out, rb...)
+		case equal(ra, rb):
+			out = append(out, ra...)
+		default:
+			return "", ErrConflict
+		}
+		pos = end
+	}
+	out = append(out, orig[pos:]...)
+	return strings.Join(out, ""), nil
+}
+
+// hunk replaces lines [start, end) of the original with lines.
+type hunk struct {
+	start, end int
+	lines      []string
+}
+
+// diff returns the hunks that turn a into b, using the longest common
+// subsequence of lines. It reports false if the changed lines are too many
+// to compare.
+func diff(a, b []string) ([]hunk, bool) {
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	x, y := a[pre:len(a)-suf], b[pre:len(b)-suf]
+	if len(x) > 0 && len(y) > maxDiffCells/len(x) {
+		return nil, false
+	}
+
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []hunk
+	open := false
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		if i < len(x) && j < len(y) && x[i] == y[j] {
+			open = false
+			i++
+			j++
+			continue
+		}
+		if !open {
+			out = append(out, hunk{start: pre + i, end: pre + i})
+			open = true
+		}
+		h := &out[len(out)-1]
+		if j < len(y) && (i == len(x) || lcs[i][j+1] >= lcs[i+1][j]) {
+			h.lines = append(h.lines, y[j])
+			j++
+		} else {
+			i++
+			h.end = pre + i
+		}
+	}
+	return out, true
+}
+
+// replace returns lines [start, end) of orig with the hunks applied.
+func replace(orig []string, start, end int, hunks []hunk) []string {
+	var out []string
+	pos := start
+	for _, h := range hunks {
+		out = append(out, orig[pos:h.start]...)
+		out = append(out, h.lines...)
+		pos = h.end
+	}
+	return append(out, orig[pos:end]...)
+}
+
+// splitLines splits text after each newline, keeping the newlines so the
+// lines join back into the original text.
+func splitLines(text string) []string {
+	var out []string
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			out = append(out, text)
+			break
+		}
+		out = append(out, text[:i+1])
+		text = text[i+1:]
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
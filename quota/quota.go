@@ -0,0 +1,113 @@
+// Package quota limits how much each account may store. Every account is on
+// a plan, and plans override the default limits.
+package quota
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Resources an account's usage is limited on.
+const (
+	Pages     = "pages"
+	TextBytes = "text"
+	Storage   = "storage"
+)
+
+// Limits bounds an account's usage. A limit of zero means unlimited.
+type Limits struct {
+	Pages     int64 // Pages owned
+	TextBytes int64 // Bytes of text in a single page
+	Storage   int64 // Bytes attached to owned pages
+}
+
+// ExceededError reports a write that would take an account past a limit.
+type ExceededError struct {
+	Resource string
+	Used     int64
+	Limit    int64
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("Quota exceeded for %s: %d of %d used", e.Resource, e.Used, e.Limit)
+}
+
+// check returns an ExceededError if adding n to used would pass limit.
+func check(resource string, used, n, limit int64) error {
+	if limit > 0 && used+n > limit {
+		return &ExceededError{Resource: resource, Used: used, Limit: limit}
+	}
+	return nil
+}
+
+// CheckPages checks that n more pages fit alongside count existing ones.
+func (l Limits) CheckPages(count, n int64) error {
+	return check(Pages, count, n, l.Pages)
+}
+
+// CheckText checks that text fits in a single page.
+func (l Limits) CheckText(text string) error {
+	if l.TextBytes > 0 && int64(len(text)) > l.TextBytes {
+		return &ExceededError{Resource: TextBytes, Used: int64(len(text)), Limit: l.TextBytes}
+	}
+	return nil
+}
+
+// CheckStorage checks that n more bytes fit alongside used stored ones.
+func (l Limits) CheckStorage(used, n int64) error {
+	return check(Storage, used, n, l.Storage)
+}
+
+// Remaining returns how much more of a limit can be used, or -1 if the
+// limit is unlimited.
+func Remaining(used, limit int64) int64 {
+	if limit == 0 {
+		return -1
+	}
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
+
+// ParsePlans parses plan definitions separated by semicolons. Each plan is a
+// name followed by a colon and comma separated resource limits, such as
+// "pro:pages=10000,storage=1073741824". Resources a plan leaves out keep
+// their default limits.
+func ParsePlans(s string, defaults Limits) (map[string]Limits, error) {
+	plans := make(map[string]Limits)
+	for _, def := range strings.Split(s, ";") {
+		def = strings.TrimSpace(def)
+		if def == "" {
+			continue
+		}
+		i := strings.Index(def, ":")
+		if i < 1 {
+			return nil, fmt.Errorf("quota: plan '%s' has no name", def)
+		}
+		name, limits := strings.TrimSpace(def[:i]), defaults
+		for _, field := range strings.Split(def[i+1:], ",") {
+			kv := strings.SplitN(strings.TrimSpace(field), "=", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("quota: invalid limit '%s' in plan '%s'", field, name)
+			}
+			n, err := strconv.ParseInt(strings.TrimSpace(kv[1]), 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("quota: invalid limit '%s' in plan '%s'", field, name)
+			}
+			switch strings.TrimSpace(kv[0]) {
+			case Pages:
+				limits.Pages = n
+			case TextBytes:
+				limits.TextBytes = n
+			case Storage:
+				limits.Storage = n
+			default:
+				return nil, fmt.Errorf("quota: unknown resource '%s' in plan '%s'", kv[0], name)
+			}
+		}
+		plans[name] = limits
+	}
+	return plans, nil
+}
@@ -0,0 +1,152 @@
+// Package render converts page Markdown to HTML. It supports the common
+// subset of Markdown used in pages: headings, paragraphs, lists, block
+// quotes, code, emphasis, links and [[wiki links]].
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// LinkFunc returns the URL a [[wiki link]] to ref points to, or an empty
+// string to render the link as plain text.
+type LinkFunc func(ref string) string
+
+var (
+	heading    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rule       = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	bullet     = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	numbered   = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	quote      = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	fence      = regexp.MustCompile("^\\s*(```|~~~)")
+	wikiLink   = regexp.MustCompile(`\[\[([^\[\]\n]+)\]\]`)
+	mdLink     = regexp.MustCompile(`\[([^\[\]]+)\]\(([^()\s]+)\)`)
+	strong     = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	emphasis   = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+	safeScheme = regexp.MustCompile(`^(?i)(https?:|mailto:|[^:]*$)`)
+)
+
+// HTML renders Markdown text as HTML. Raw HTML in text is escaped. Wiki links
+// are resolved with link, which may be nil.
+func HTML(text string, link LinkFunc) string {
+	var buf bytes.Buffer
+	renderBlocks(&buf, strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n"), link)
+	return buf.String()
+}
+
+func renderBlocks(buf *bytes.Buffer, lines []string, link LinkFunc) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case strings.TrimSpace(line) == "":
+			i++
+		case fence.MatchString(line):
+			marker := fence.FindStringSubmatch(line)[1]
+			i++
+			var code []string
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), marker) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence
+			fmt.Fprintf(buf, "<pre><code>%s</code></pre>\n", html.EscapeString(strings.Join(code, "\n")))
+		case heading.MatchString(line):
+			m := heading.FindStringSubmatch(line)
+			fmt.Fprintf(buf, "<h%d>%s</h%d>\n", len(m[1]), inline(m[2], link), len(m[1]))
+			i++
+		case rule.MatchString(line):
+			buf.WriteString("<hr>\n")
+			i++
+		case quote.MatchString(line):
+			var inner []string
+			for i < len(lines) && quote.MatchString(lines[i]) {
+				inner = append(inner, quote.FindStringSubmatch(lines[i])[1])
+				i++
+			}
+			buf.WriteString("<blockquote>\n")
+			renderBlocks(buf, inner, link)
+			buf.WriteString("</blockquote>\n")
+		case bullet.MatchString(line):
+			i = renderList(buf, lines, i, bullet, "ul", link)
+		case numbered.MatchString(line):
+			i = renderList(buf, lines, i, numbered, "ol", link)
+		default:
+			var para []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" && !startsBlock(lines[i]) {
+				para = append(para, strings.TrimSpace(lines[i]))
+				i++
+			}
+			fmt.Fprintf(buf, "<p>%s</p>\n", inline(strings.Join(para, "\n"), link))
+		}
+	}
+}
+
+// renderList renders consecutive list items matching item starting at line
+// i and returns the index of the first line after the list.
+func renderList(buf *bytes.Buffer, lines []string, i int, item *regexp.Regexp, tag string, link LinkFunc) int {
+	fmt.Fprintf(buf, "<%s>\n", tag)
+	for i < len(lines) && item.MatchString(lines[i]) {
+		fmt.Fprintf(buf, "<li>%s</li>\n", inline(item.FindStringSubmatch(lines[i])[1], link))
+		i++
+	}
+	fmt.Fprintf(buf, "</%s>\n", tag)
+	return i
+}
+
+// startsBlock reports whether a line begins a block other than a paragraph.
+func startsBlock(line string) bool {
+	return fence.MatchString(line) || heading.MatchString(line) || rule.MatchString(line) ||
+		quote.MatchString(line) || bullet.MatchString(line) || numbered.MatchString(line)
+}
+
+// inline renders the inline Markdown of a block. Code spans are rendered
+// first so their content is left as written.
+func inline(text string, link LinkFunc) string {
+	parts := strings.Split(text, "`")
+	var buf bytes.Buffer
+	for i, part := range parts {
+		if i%2 == 1 && i < len(parts)-1 {
+			fmt.Fprintf(&buf, "<code>%s</code>", html.EscapeString(part))
+			continue
+		}
+		if i%2 == 1 {
+			buf.WriteString("`")
+		}
+		buf.WriteString(spans(part, link))
+	}
+	return buf.String()
+}
+
+// spans renders links and emphasis in text that contains no code.
+func spans(text string, link LinkFunc) string {
+	text = html.EscapeString(text)
+	text = wikiLink.ReplaceAllStringFunc(text, func(m string) string {
+		ref := html.UnescapeString(wikiLink.FindStringSubmatch(m)[1])
+		label := ref
+		if i := strings.Index(ref, "|"); i >= 0 {
+			ref, label = ref[:i], ref[i+1:]
+		}
+		ref, label = strings.TrimSpace(ref), strings.TrimSpace(label)
+		href := ""
+		if link != nil {
+			href = link(ref)
+		}
+		if href == "" {
+			return html.EscapeString(label)
+		}
+		return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(href), html.EscapeString(label))
+	})
+	text = mdLink.ReplaceAllStringFunc(text, func(m string) string {
+		sub := mdLink.FindStringSubmatch(m)
+		if !safeScheme.MatchString(html.UnescapeString(sub[2])) {
+			return sub[1]
+		}
+		return fmt.Sprintf(`<a href="%s">%s</a>`, sub[2], sub[1])
+	})
+	text = strong.ReplaceAllString(text, "<strong>$1$2</strong>")
+	text = emphasis.ReplaceAllString(text, "<em>$1$2</em>")
+	return text
+}
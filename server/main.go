@@ -1,29 +1,146 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"golang.org/x/net/context"
 	"golang.org/x/net/trace"
 
+	"github.com/nathanborror/pages/archive"
+	"github.com/nathanborror/pages/moderation"
 	"github.com/nathanborror/pages/pages"
+	"github.com/nathanborror/pages/patch"
+	"github.com/nathanborror/pages/quota"
 	"github.com/nathanborror/pages/server/proxy"
 	"github.com/nathanborror/pages/state"
+	"github.com/nathanborror/pages/state/broker"
+	"github.com/nathanborror/pages/state/disk"
 	"github.com/nathanborror/pages/state/memory"
 	"github.com/nathanborror/pages/state/sqlite"
+	"github.com/nathanborror/pages/template"
 	"github.com/nathanborror/pages/utils"
+	"github.com/nathanborror/pages/webhook"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const ctxAccountAuthorizationID = "AccountAuthorizationID"
 
+// watchBuffer is the number of events a PageWatch stream may fall behind by
+// before it is disconnected.
+const watchBuffer = 64
+
+// patchAttempts is the number of times PagePatch re-merges a patch after
+// losing a race with another writer.
+const patchAttempts = 3
+
+// maxBatchSize is the maximum number of items in a batch request.
+const maxBatchSize = 1000
+
+// maxPatchOps is the maximum number of text operations in a patch request.
+const maxPatchOps = 1000
+
+// maxImportSize is the largest account archive AccountImport accepts.
+const maxImportSize = 64 << 20 // 64MB
+
+// maxImportUnpacked is the most page data AccountImport reads out of an
+// archive once it is decompressed.
+const maxImportUnpacked = 256 << 20 // 256MB
+
+// maxFrontMatterSize is how much larger than the text quota a page's file
+// in an imported archive may be, to leave room for its front-matter.
+const maxFrontMatterSize = 4 << 10 // 4KB
+
+// attachmentChunkSize is the size of the chunks attachments are downloaded in.
+const attachmentChunkSize = 64 * 1024
+
+// viewWindow is how long after a counted view further views of the same page
+// by the same viewer are ignored.
+const viewWindow = 30 * time.Minute
+
+// statsDays is the number of days PageStats covers by default.
+const statsDays = 30
+
+// maxStatsDays is the most days PageStats covers.
+const maxStatsDays = 365
+
+// statsTopPages is the number of pages account stats rank.
+const statsTopPages = 10
+
+// scheduleInterval is how often scheduled pages are checked for publishing.
+const scheduleInterval = time.Second
+
+// webhookBuffer is the number of page events webhook queueing may fall
+// behind by before it's woken by the next check instead.
+const webhookBuffer = 1024
+
+// webhookInterval is how often page events and queued webhook deliveries
+// are checked.
+const webhookInterval = time.Second
+
+// webhookBatch is the most deliveries attempted at once.
+const webhookBatch = 100
+
+// webhookTimeout is how long a webhook receiver has to respond.
+const webhookTimeout = 10 * time.Second
+
+// maxWebhookBackoff is the longest wait between delivery attempts.
+const maxWebhookBackoff = time.Hour
+
+// webhookDeliveriesLimit is the number of deliveries WebhookDeliveries
+// lists by default.
+const webhookDeliveriesLimit = 50
+
+// maxWebhookDeliveriesLimit is the most deliveries WebhookDeliveries lists.
+const maxWebhookDeliveriesLimit = 500
+
+// changesLimit is the number of changes ChangesSince returns by default.
+const changesLimit = 100
+
+// maxChangesLimit is the most changes ChangesSince returns.
+const maxChangesLimit = 1000
+
+// lockTTL is how long an edit lease lasts when no duration is given.
+const lockTTL = 5 * time.Minute
+
+// maxLockTTL is the longest an edit lease may last before it's renewed.
+const maxLockTTL = time.Hour
+
+// publicMethods can be called without authenticating.
+var publicMethods = map[string]bool{
+	"/Accounts/Register":        true,
+	"/Accounts/Connect":         true,
+	"/Pages/PageList":           true,
+	"/Pages/PageGet":            true,
+	"/Pages/PageWatch":          true,
+	"/Pages/ChangesSince":       true,
+	"/Pages/PageBacklinks":      true,
+	"/Pages/PageOutlinks":       true,
+	"/Pages/AttachmentDownload": true,
+	"/Comments/CommentList":     true,
+}
+
 var (
 	// ErrAccessDenied means the request was missing token meta-data.
 	ErrAccessDenied = grpc.Errorf(codes.PermissionDenied, "Access denied")
@@ -31,137 +148,2402 @@ var (
 	// ErrAccessDeniedMissingToken means the token meta-data value was empty.
 	ErrAccessDeniedMissingToken = grpc.Errorf(codes.Unauthenticated, "Missing authentication token")
 
-	// ErrAccessDeniedInvalidToken means the provided token was invalid.
-	ErrAccessDeniedInvalidToken = grpc.Errorf(codes.Unauthenticated, "Invalid authentication token")
+	// ErrAccessDeniedInvalidToken means the provided token was invalid.
+	ErrAccessDeniedInvalidToken = grpc.Errorf(codes.Unauthenticated, "Invalid authentication token")
+
+	// ErrMissingName means the account name is missing.
+	ErrMissingName = grpc.Errorf(codes.InvalidArgument, "Missing name")
+
+	// ErrMissingEmail means the account email address is missing.
+	ErrMissingEmail = grpc.Errorf(codes.InvalidArgument, "Missing email address")
+
+	// ErrMissingPassword means the account password is missing.
+	ErrMissingPassword = grpc.Errorf(codes.InvalidArgument, "Missing password")
+
+	// ErrAdminOnly means the method may only be called by an admin.
+	ErrAdminOnly = grpc.Errorf(codes.PermissionDenied, "Admin access required")
+
+	// ErrUnknownPlan means no quota plan has the given name.
+	ErrUnknownPlan = grpc.Errorf(codes.InvalidArgument, "Unknown plan")
+
+	// ErrArchiveTooLarge means an imported archive exceeds maxImportSize, or
+	// decompresses to more than maxImportUnpacked or to a page file too large
+	// for the text quota.
+	ErrArchiveTooLarge = grpc.Errorf(codes.InvalidArgument, "Archive is too large")
+
+	// ErrInvalidArchive means an imported archive couldn't be read.
+	ErrInvalidArchive = grpc.Errorf(codes.InvalidArgument, "Invalid archive")
+
+	// ErrMissingText means the page text is missing.
+	ErrMissingText = grpc.Errorf(codes.InvalidArgument, "Missing text")
+
+	// ErrInvalidUpdateMask means an update mask named a field that can't be updated.
+	ErrInvalidUpdateMask = grpc.Errorf(codes.InvalidArgument, "Update mask may only name %s", strings.Join(state.UpdateFields, " and "))
+
+	// ErrTemplateWithText means a page was given both text and a template.
+	ErrTemplateWithText = grpc.Errorf(codes.InvalidArgument, "Pages created from a template cannot also set text")
+
+	// ErrMissingPublishAt means a page was scheduled without a publish time.
+	ErrMissingPublishAt = grpc.Errorf(codes.InvalidArgument, "Missing publish at time")
+
+	// ErrQuarantineStatus means a write asked for the quarantined status,
+	// which only moderation may set.
+	ErrQuarantineStatus = grpc.Errorf(codes.InvalidArgument, "Pages can only be quarantined by moderation")
+
+	// ErrPageQuarantined means a quarantined page's status can't change until
+	// an admin reviews it.
+	ErrPageQuarantined = grpc.Errorf(codes.FailedPrecondition, "Page is quarantined pending review")
+
+	// ErrMissingVerdict means a moderation review gave no verdict.
+	ErrMissingVerdict = grpc.Errorf(codes.InvalidArgument, "Missing verdict")
+
+	// ErrAlreadyReviewed means the moderation decision was already reviewed.
+	ErrAlreadyReviewed = grpc.Errorf(codes.FailedPrecondition, "Decision has already been reviewed")
+
+	// ErrInvalidWebhookURL means a webhook URL wasn't an absolute http or https
+	// URL, or pointed at a local or private address.
+	ErrInvalidWebhookURL = grpc.Errorf(codes.InvalidArgument, "Webhook URL must be an absolute http or https URL with a public host")
+
+	// ErrInvalidLimit means a list limit is negative or exceeds its maximum.
+	ErrInvalidLimit = grpc.Errorf(codes.InvalidArgument, "Limit must be between 1 and %d", maxWebhookDeliveriesLimit)
+
+	// ErrInvalidCursor means a change cursor is negative.
+	ErrInvalidCursor = grpc.Errorf(codes.InvalidArgument, "Cursor must not be negative")
+
+	// ErrInvalidChangesLimit means a changes limit is negative or exceeds maxChangesLimit.
+	ErrInvalidChangesLimit = grpc.Errorf(codes.InvalidArgument, "Limit must be between 1 and %d", maxChangesLimit)
+
+	// ErrPageChanged means a synced delete was of a page changed since its base version.
+	ErrPageChanged = grpc.Errorf(codes.FailedPrecondition, "Page changed since base version")
+
+	// ErrPageLocked means the page is leased for editing by another account.
+	ErrPageLocked = grpc.Errorf(codes.FailedPrecondition, "Page is locked for editing by another account")
+
+	// ErrLockNotHeld means the account doesn't hold the page's edit lease.
+	ErrLockNotHeld = grpc.Errorf(codes.FailedPrecondition, "Page lock is not held by account")
+
+	// ErrInvalidTTL means a lease duration is negative or exceeds maxLockTTL.
+	ErrInvalidTTL = grpc.Errorf(codes.InvalidArgument, "Lease duration must be between 1 and %d seconds", int(maxLockTTL/time.Second))
+
+	// ErrInvalidDays means the stats range is negative or exceeds maxStatsDays.
+	ErrInvalidDays = grpc.Errorf(codes.InvalidArgument, "Days must be between 1 and %d", maxStatsDays)
+
+	// ErrInvalidAsOf means a point in time read was given a negative time.
+	ErrInvalidAsOf = grpc.Errorf(codes.InvalidArgument, "As of time must not be negative")
+
+	// ErrNotModified means the client's copy of a conditional read is current.
+	// The gateway answers it with 304 Not Modified.
+	ErrNotModified = grpc.Errorf(codes.FailedPrecondition, "Not modified")
+
+	// ErrMissingPatch means the patch had neither operations nor a diff.
+	ErrMissingPatch = grpc.Errorf(codes.InvalidArgument, "Missing ops or diff")
+
+	// ErrTooManyOps means a patch request has more than maxPatchOps operations.
+	ErrTooManyOps = grpc.Errorf(codes.InvalidArgument, "Patch exceeds %d ops", maxPatchOps)
+
+	// ErrInvalidPatch means the patch couldn't be applied to its base version.
+	ErrInvalidPatch = grpc.Errorf(codes.InvalidArgument, "Patch does not apply to base version")
+
+	// ErrPatchConflict means the patch conflicts with changes made since its base version.
+	ErrPatchConflict = grpc.Errorf(codes.Aborted, "Patch conflicts with newer changes")
+
+	// ErrBatchTooLarge means a batch request has more than maxBatchSize items.
+	ErrBatchTooLarge = grpc.Errorf(codes.InvalidArgument, "Batch exceeds %d items", maxBatchSize)
+
+	// ErrMissingRole means the collaborator role is missing.
+	ErrMissingRole = grpc.Errorf(codes.InvalidArgument, "Missing role")
+
+	// ErrInvalidCollaborator means the page was shared with its own author.
+	ErrInvalidCollaborator = grpc.Errorf(codes.InvalidArgument, "Pages cannot be shared with their author")
+
+	// ErrMissingAttachment means an attachment upload contained no chunks.
+	ErrMissingAttachment = grpc.Errorf(codes.InvalidArgument, "Missing attachment")
+
+	// ErrMissingFilename means the attachment file name is missing.
+	ErrMissingFilename = grpc.Errorf(codes.InvalidArgument, "Missing file name")
+
+	// ErrAttachmentTooLarge means the attachment exceeds the maximum attachment size.
+	ErrAttachmentTooLarge = grpc.Errorf(codes.InvalidArgument, "Attachment is too large")
+
+	// ErrInvalidAnchor means the comment anchor lies outside its page version's text.
+	ErrInvalidAnchor = grpc.Errorf(codes.InvalidArgument, "Anchor is outside the page text")
+
+	// ErrAnchoredReply means a reply was given an anchor. Replies share the
+	// anchor of the comment that starts their thread.
+	ErrAnchoredReply = grpc.Errorf(codes.InvalidArgument, "Replies cannot be anchored")
+
+	// ErrWatchBehind means a watch stream couldn't keep up with page events.
+	ErrWatchBehind = grpc.Errorf(codes.ResourceExhausted, "Watch fell behind, reconnect to resume")
+)
+
+type server struct {
+	state  state.State
+	broker *broker.Broker
+	blobs  state.BlobStore
+
+	maxAttachmentSize int64
+
+	// Accounts get the limits of their plan, or the default limits if
+	// their plan isn't one of plans.
+	limits quota.Limits
+	plans  map[string]quota.Limits
+
+	// admins holds the IDs of accounts that may administer the server.
+	admins map[string]bool
+
+	// Page text is checked by moderator before it's written. The classifier
+	// is one of its checkers and learns from admin reviews.
+	moderator  *moderation.Pipeline
+	classifier *moderation.Classifier
+
+	// Failed webhook deliveries are retried, waiting webhookBackoff and
+	// doubling the wait each time, until webhookAttempts have been made.
+	webhookClient   *http.Client
+	webhookPrivate  bool
+	webhookAttempts int64
+	webhookBackoff  time.Duration
+
+	// viewSalt is mixed into viewer hashes so recorded views can't be
+	// traced back to an account or address. Unless SERVER_VIEW_SALT is set
+	// it changes every time the server starts, so views from before a
+	// restart aren't recognized as repeats.
+	viewSalt string
+
+	// gateways holds the addresses the gateway connects from. Only their
+	// requests are trusted to say who the client is with x-forwarded-for.
+	gateways map[string]bool
+}
+
+// Accounts Server
+
+func (s *server) Register(ctx context.Context, in *pages.RegisterRequest) (*pages.Session, error) {
+	if in.Name == "" {
+		return nil, ErrMissingName
+	}
+	if in.Email == "" {
+		return nil, ErrMissingEmail
+	}
+	if in.Password == "" {
+		return nil, ErrMissingPassword
+	}
+	account, err := s.state.AccountCreate(in.Name, in.Email, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.state.AccountTokenSet(account.Id)
+	if err != nil {
+		return nil, err
+	}
+	session := &pages.Session{Account: account, Token: token}
+	return session, nil
+}
+
+func (s *server) Connect(ctx context.Context, in *pages.ConnectRequest) (*pages.Session, error) {
+	if in.Identifier == "" {
+		return nil, ErrMissingEmail
+	}
+	if in.Password == "" {
+		return nil, ErrMissingPassword
+	}
+	account, err := s.state.AccountForEmail(in.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.state.AccountForPassword(account.Id, in.Password); err != nil {
+		return nil, err
+	}
+	token, err := s.state.AccountTokenSet(account.Id)
+	if err != nil {
+		return nil, err
+	}
+	session := &pages.Session{Account: account, Token: token}
+	return session, nil
+}
+
+func (s *server) AccountExport(in *pages.AccountExportRequest, stream pages.Accounts_AccountExportServer) error {
+	account := s.authorizedAccount(stream.Context())
+	if account == nil {
+		return ErrAccessDenied
+	}
+	recs, err := s.state.PagesForAccount(account.Id)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(&archiveWriter{stream}, attachmentChunkSize)
+	if err := archive.Write(w, in.Format, account, recs); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (s *server) AccountImport(stream pages.Accounts_AccountImportServer) error {
+	accountID := s.authorizedAccountID(stream.Context())
+	var buf bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if buf.Len()+len(chunk.Data) > maxImportSize {
+			return ErrArchiveTooLarge
+		}
+		buf.Write(chunk.Data)
+	}
+	account, err := s.state.Account(accountID)
+	if err != nil {
+		return err
+	}
+	maxFile := s.limitsFor(account).TextBytes
+	if maxFile > 0 {
+		maxFile += maxFrontMatterSize
+	}
+	recs, err := archive.Read(buf.Bytes(), maxFile, maxImportUnpacked)
+	if err == archive.ErrTooLarge {
+		return ErrArchiveTooLarge
+	} else if err != nil {
+		return ErrInvalidArchive
+	}
+
+	// Pages are matched by ID so importing the same archive twice leaves
+	// pages unchanged. Pages without an ID are always created.
+	result := &pages.AccountImportResult{}
+	ts := time.Now().UTC().UnixNano()
+	for _, rec := range recs {
+		if rec.Id == "" {
+			rec.Id = utils.RandSha1()
+		}
+		if !importID.MatchString(rec.Id) {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: Invalid page ID", rec.Id))
+			continue
+		}
+		if rec.Created == 0 {
+			rec.Created = ts
+		}
+		if rec.Modified == 0 {
+			rec.Modified = rec.Created
+		}
+		if rec.Status == pages.PageStatus_PUBLISHED && rec.PublishAt == 0 {
+			rec.PublishAt = rec.Created
+		}
+		created := false
+		existing, err := s.state.Page(rec.Id)
+		switch {
+		case err == state.ErrPageNotFound:
+			created = true
+		case err != nil:
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, err))
+			continue
+		case existing.Account.Id != accountID:
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, state.ErrPageUnauthorized))
+			continue
+		case existing.Text == rec.Text && existing.Visibility == rec.Visibility && existing.Status == rec.Status && existing.PublishAt == rec.PublishAt:
+			result.Unchanged++
+			continue
+		}
+		if err := s.checkText(accountID, rec.Text); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, err))
+			continue
+		}
+		if created {
+			if err := s.checkPages(accountID, 0, 1); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, err))
+				continue
+			}
+		}
+		pageID := rec.Id
+		if created {
+			pageID = ""
+		}
+		d, err := s.moderate(accountID, pageID, rec.Text)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, grpc.ErrorDesc(err)))
+			continue
+		}
+
+		// Archives can neither quarantine pages nor release them. Pages
+		// moderation holds keep the status they were first held from.
+		status, publishAt := rec.Status, rec.PublishAt
+		switch {
+		case !created && existing.Status == pages.PageStatus_QUARANTINED:
+			if status, publishAt, err = s.heldStatus(rec.Id); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, err))
+				continue
+			}
+			rec.Status, rec.PublishAt = pages.PageStatus_QUARANTINED, existing.PublishAt
+		case rec.Status == pages.PageStatus_QUARANTINED:
+			status, publishAt = pages.PageStatus_DRAFT, 0
+			rec.Status, rec.PublishAt = status, publishAt
+		}
+		if d.Action == pages.ModerationAction_QUARANTINE {
+			rec.Status = pages.PageStatus_QUARANTINED
+		}
+		if _, err := s.state.PageRestore(accountID, rec); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rec.Id, err))
+			continue
+		}
+		if d.Action == pages.ModerationAction_QUARANTINE {
+			if _, err := s.record(rec.Id, accountID, rec.Text, d, status, publishAt); err != nil {
+				return err
+			}
+		}
+		if created {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+	}
+	return stream.SendAndClose(result)
+}
+
+// importID matches the page IDs an import may create.
+var importID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// archiveWriter sends everything written to it as archive chunks.
+type archiveWriter struct {
+	stream pages.Accounts_AccountExportServer
+}
+
+func (w *archiveWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pages.ArchiveChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *server) QuotaGet(ctx context.Context, in *pages.Empty) (*pages.Quota, error) {
+	account := s.authorizedAccount(ctx)
+	if account == nil {
+		return nil, ErrAccessDenied
+	}
+	count, storage, err := s.state.AccountUsage(account.Id)
+	if err != nil {
+		return nil, err
+	}
+	limits := s.limitsFor(account)
+	return &pages.Quota{
+		Plan:         account.Plan,
+		Pages:        count,
+		MaxPages:     limits.Pages,
+		Storage:      storage,
+		MaxStorage:   limits.Storage,
+		MaxTextBytes: limits.TextBytes,
+	}, nil
+}
+
+func (s *server) AccountPlanSet(ctx context.Context, in *pages.AccountPlanSetRequest) (*pages.Account, error) {
+	if !s.admins[s.authorizedAccountID(ctx)] {
+		return nil, ErrAdminOnly
+	}
+	if _, ok := s.plans[in.Plan]; !ok && in.Plan != "" {
+		return nil, ErrUnknownPlan
+	}
+	return s.state.AccountPlanSet(in.AccountId, in.Plan)
+}
+
+// Pages Server
+
+func (s *server) PageCreate(ctx context.Context, in *pages.PageCreateRequest) (*pages.Page, error) {
+	accountID := s.authorizedAccountID(ctx)
+	text, err := s.pageText(accountID, in)
+	if err != nil {
+		return nil, err
+	}
+	if in.Status == pages.PageStatus_SCHEDULED && in.PublishAt == 0 {
+		return nil, ErrMissingPublishAt
+	}
+	if in.Status == pages.PageStatus_QUARANTINED {
+		return nil, ErrQuarantineStatus
+	}
+	if err := s.checkText(accountID, text); err != nil {
+		return nil, quotaTrailer(ctx, err)
+	}
+	if err := s.checkPages(accountID, 0, 1); err != nil {
+		return nil, quotaTrailer(ctx, err)
+	}
+	d, err := s.moderate(accountID, "", text)
+	if err != nil {
+		return nil, err
+	}
+	if d.Action != pages.ModerationAction_QUARANTINE {
+		return s.state.PageCreate(accountID, text, in.Visibility, in.Status, in.PublishAt)
+	}
+	page, err := s.state.PageCreate(accountID, text, in.Visibility, pages.PageStatus_QUARANTINED, in.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.record(page.Id, accountID, text, d, in.Status, in.PublishAt); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s *server) PageUpdate(ctx context.Context, in *pages.PageUpdateRequest) (*pages.Page, error) {
+	fields, err := updateFields(in)
+	if err != nil {
+		return nil, err
+	}
+	accountID := s.authorizedAccountID(ctx)
+	if err := s.checkLock(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	var d moderation.Decision
+	if state.HasField(fields, state.FieldText) {
+		if err := s.checkPageText(in.Id, in.Text); err != nil {
+			return nil, quotaTrailer(ctx, err)
+		}
+		if d, err = s.moderate(accountID, in.Id, in.Text); err != nil {
+			return nil, err
+		}
+	}
+	page, err := s.state.PageUpdate(in.Id, accountID, in.Text, in.Visibility, fields)
+	if err != nil || d.Action != pages.ModerationAction_QUARANTINE {
+		return page, err
+	}
+
+	// Quarantining first would leave the page held if the update failed.
+	if err := s.quarantine(in.Id, accountID, in.Text, d); err != nil {
+		return nil, err
+	}
+	return s.state.Page(in.Id)
+}
+
+func (s *server) PagePatch(ctx context.Context, in *pages.PagePatchRequest) (*pages.Page, error) {
+	if len(in.Ops) == 0 && in.Diff == "" {
+		return nil, ErrMissingPatch
+	}
+	if len(in.Ops) > maxPatchOps {
+		return nil, ErrTooManyOps
+	}
+	accountID := s.authorizedAccountID(ctx)
+	role, err := s.state.PageRole(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if role < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
+	}
+
+	// The text ops insert must fit the page on its own, so a patch can't
+	// grow the text far past the quota before the result is checked.
+	inserted := make([]string, len(in.Ops))
+	for i, op := range in.Ops {
+		inserted[i] = op.Text
+	}
+	if err := s.checkPageText(in.Id, strings.Join(inserted, "")); err != nil {
+		return nil, quotaTrailer(ctx, err)
+	}
+	base, err := s.state.PageRevision(in.Id, in.BaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	edited, err := applyPatch(base, in)
+	if err != nil {
+		return nil, ErrInvalidPatch
+	}
+	return s.patchPage(ctx, accountID, in.Id, in.BaseVersion, base, edited)
+}
+
+// patchPage saves edited, an edit of the text of a page at base version, as
+// the page's text. If the page has changed since, the edit is merged with
+// its current text.
+func (s *server) patchPage(ctx context.Context, accountID, id string, version int64, base, edited string) (*pages.Page, error) {
+	for i := 0; i < patchAttempts; i++ {
+		current, err := s.state.Page(id)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkLock(id, accountID); err != nil {
+			return nil, err
+		}
+		text := edited
+		if current.Version != version {
+			if text, err = patch.Merge(base, edited, current.Text); err != nil {
+				return nil, ErrPatchConflict
+			}
+		}
+		if err := s.checkText(current.Account.Id, text); err != nil {
+			return nil, quotaTrailer(ctx, err)
+		}
+		d, err := s.moderate(accountID, id, text)
+		if err != nil {
+			return nil, err
+		}
+		page, err := s.state.PagePatch(id, accountID, current.Version, text)
+		if err == state.ErrPageStale {
+			continue
+		}
+		if err != nil || d.Action != pages.ModerationAction_QUARANTINE {
+			return page, err
+		}
+		if err := s.quarantine(id, accountID, text, d); err != nil {
+			return nil, err
+		}
+		return s.state.Page(id)
+	}
+	return nil, ErrPatchConflict
+}
+
+func (s *server) PageStatusUpdate(ctx context.Context, in *pages.PageStatusUpdateRequest) (*pages.Page, error) {
+	if in.Status == pages.PageStatus_SCHEDULED && in.PublishAt == 0 {
+		return nil, ErrMissingPublishAt
+	}
+	if in.Status == pages.PageStatus_QUARANTINED {
+		return nil, ErrQuarantineStatus
+	}
+	accountID := s.authorizedAccountID(ctx)
+
+	// Only owners learn that a page is quarantined. Callers without a role
+	// can't tell the page from one that doesn't exist.
+	role, err := s.state.PageRole(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if role == pages.Role_NONE {
+		return nil, state.ErrPageNotFound
+	}
+	if role != pages.Role_OWNER {
+		return nil, state.ErrPageUnauthorized
+	}
+	page, err := s.state.Page(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if page.Status == pages.PageStatus_QUARANTINED {
+		return nil, ErrPageQuarantined
+	}
+	return s.state.PageStatusUpdate(in.Id, accountID, in.Status, in.PublishAt)
+}
+
+func (s *server) PageDelete(ctx context.Context, in *pages.PageDeleteRequest) (*pages.Page, error) {
+	accountID := s.authorizedAccountID(ctx)
+	page, err := s.state.Page(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.PageDelete(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s *server) PageBatchCreate(ctx context.Context, in *pages.PageBatchCreateRequest) (*pages.PageBatchResult, error) {
+	if len(in.Pages) > maxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	atomic := in.Mode == pages.BatchMode_ATOMIC
+	accountID := s.authorizedAccountID(ctx)
+	errs := make([]error, len(in.Pages))
+	held := make(map[int]moderation.Decision)
+	var valid []*pages.PageCreateRequest
+	for i, item := range in.Pages {
+		text, err := s.pageText(accountID, item)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if item.Status == pages.PageStatus_SCHEDULED && item.PublishAt == 0 {
+			errs[i] = ErrMissingPublishAt
+			continue
+		}
+		if item.Status == pages.PageStatus_QUARANTINED {
+			errs[i] = ErrQuarantineStatus
+			continue
+		}
+		if err := s.checkText(accountID, text); err != nil {
+			errs[i] = quotaError(err)
+			continue
+		}
+		if err := s.checkPages(accountID, len(valid), 1); err != nil {
+			errs[i] = quotaError(err)
+			continue
+		}
+		d, err := s.moderate(accountID, "", text)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		status := item.Status
+		if d.Action == pages.ModerationAction_QUARANTINE {
+			held[i] = d
+			status = pages.PageStatus_QUARANTINED
+		}
+		valid = append(valid, &pages.PageCreateRequest{Text: text, Visibility: item.Visibility, Status: status, PublishAt: item.PublishAt})
+	}
+	if atomic && state.AbortBatch(errs) {
+		return batchResult(nil, errs, true), nil
+	}
+	created, createErrs, err := s.state.PageBatchCreate(accountID, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+	created, errs = merge(errs, created, createErrs)
+	for i, d := range held {
+		if errs[i] != nil {
+			continue
+		}
+		item := in.Pages[i]
+		if _, err := s.record(created[i].Id, accountID, created[i].Text, d, item.Status, item.PublishAt); err != nil {
+			return nil, err
+		}
+	}
+	return batchResult(created, errs, atomic), nil
+}
+
+func (s *server) PageBatchUpdate(ctx context.Context, in *pages.PageBatchUpdateRequest) (*pages.PageBatchResult, error) {
+	if len(in.Pages) > maxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	atomic := in.Mode == pages.BatchMode_ATOMIC
+	accountID := s.authorizedAccountID(ctx)
+	errs := make([]error, len(in.Pages))
+	held := make(map[int]moderation.Decision)
+	var valid []*pages.PageUpdateRequest
+	for i, item := range in.Pages {
+		fields, err := updateFields(item)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if err := s.checkLock(item.Id, accountID); err != nil {
+			errs[i] = err
+			continue
+		}
+		if state.HasField(fields, state.FieldText) {
+			if err := s.checkPageText(item.Id, item.Text); err != nil {
+				errs[i] = quotaError(err)
+				continue
+			}
+			d, err := s.moderate(accountID, item.Id, item.Text)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			if d.Action == pages.ModerationAction_QUARANTINE {
+				held[i] = d
+			}
+		}
+		valid = append(valid, item)
+	}
+	if atomic && state.AbortBatch(errs) {
+		return batchResult(nil, errs, true), nil
+	}
+	updated, updateErrs, err := s.state.PageBatchUpdate(accountID, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+	updated, errs = merge(errs, updated, updateErrs)
+
+	// Quarantining first would leave pages held if the batch is rolled
+	// back, so updated pages are quarantined once the batch has committed.
+	for i, d := range held {
+		if errs[i] != nil {
+			continue
+		}
+		item := in.Pages[i]
+		if err := s.quarantine(item.Id, accountID, item.Text, d); err != nil {
+			return nil, err
+		}
+		if updated[i], err = s.state.Page(item.Id); err != nil {
+			return nil, err
+		}
+	}
+	return batchResult(updated, errs, atomic), nil
+}
+
+func (s *server) PageBatchDelete(ctx context.Context, in *pages.PageBatchDeleteRequest) (*pages.PageBatchResult, error) {
+	if len(in.Ids) > maxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	accountID := s.authorizedAccountID(ctx)
+	atomic := in.Mode == pages.BatchMode_ATOMIC
+	deleted, errs, err := s.state.PageBatchDelete(accountID, in.Ids, atomic)
+	if err != nil {
+		return nil, err
+	}
+	return batchResult(deleted, errs, atomic), nil
+}
+
+// PageGet returns a page. Conditional reads of an unchanged page are answered
+// without reading it, so they aren't counted as views.
+func (s *server) PageGet(ctx context.Context, in *pages.PageGetRequest) (*pages.Page, error) {
+	if in.AsOf < 0 {
+		return nil, ErrInvalidAsOf
+	}
+	accountID := s.authorizedAccountID(ctx)
+
+	// Reads of the past are answered from history, without validators, and
+	// aren't counted as views.
+	if in.AsOf != 0 {
+		return s.state.PageVisibleAsOf(in.Id, accountID, in.AsOf)
+	}
+
+	// Validators are only checked once the page is known to be visible, so
+	// they don't reveal when pages the viewer can't see last changed.
+	page, err := s.state.PageVisible(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	change, err := s.state.PageChange(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	header := validators("page:"+in.Id, accountID, change)
+	if notModified(ctx, header) {
+		grpc.SendHeader(ctx, header)
+		return nil, ErrNotModified
+	}
+	if page.Account.Id != accountID {
+		if _, err := s.state.PageViewRecord(page.Id, s.viewer(ctx, accountID), time.Now().UTC().UnixNano(), int64(viewWindow)); err != nil {
+			grpclog.Printf("Failed to record page view: %v", err)
+		}
+	}
+	if err := grpc.SendHeader(ctx, header); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s *server) PageList(ctx context.Context, in *pages.PageListRequest) (*pages.PagesSet, error) {
+	if in.AsOf < 0 {
+		return nil, ErrInvalidAsOf
+	}
+	accountID := s.authorizedAccountID(ctx)
+	if in.AsOf != 0 {
+		recs, err := s.state.PagesVisibleAsOf(accountID, in.AsOf)
+		if err != nil {
+			return nil, err
+		}
+		return &pages.PagesSet{
+			Pages: recs,
+			Total: int64(len(recs)),
+			Page:  1,
+		}, nil
+	}
+	// Any page changing may change which pages are listed, so the list is
+	// validated by the latest change to any page.
+	change, err := s.state.PageChangeLatest()
+	if err != nil {
+		return nil, err
+	}
+	header := validators("pages", accountID, change)
+	if notModified(ctx, header) {
+		grpc.SendHeader(ctx, header)
+		return nil, ErrNotModified
+	}
+	recs, err := s.state.PagesVisible(accountID)
+	if err != nil {
+		return nil, err
+	}
+	out := pages.PagesSet{
+		Pages: recs,
+		Total: int64(len(recs)),
+		Page:  1,
+	}
+	if err := grpc.SendHeader(ctx, header); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (s *server) ChangesSince(ctx context.Context, in *pages.ChangesSinceRequest) (*pages.ChangesSet, error) {
+	if in.Cursor < 0 {
+		return nil, ErrInvalidCursor
+	}
+	limit := in.Limit
+	if limit == 0 {
+		limit = changesLimit
+	}
+	if limit < 0 || limit > maxChangesLimit {
+		return nil, ErrInvalidChangesLimit
+	}
+	return s.changes(s.authorizedAccountID(ctx), in.Cursor, limit)
+}
+
+// changes returns up to limit changes after cursor as seen by the viewer.
+func (s *server) changes(accountID string, cursor, limit int64) (*pages.ChangesSet, error) {
+	recs, err := s.state.PageChanges(cursor, int(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+	out := pages.ChangesSet{Cursor: cursor}
+	if int64(len(recs)) > limit {
+		recs, out.More = recs[:limit], true
+	}
+
+	// Changes the viewer can't list are left out so the feed doesn't reveal
+	// the IDs of private and unlisted pages.
+	for _, rec := range recs {
+		out.Cursor = rec.Sequence
+		if rec.Page != nil && !s.listable(rec.Page, accountID) {
+			continue
+		}
+		if rec.Page == nil && !s.listableBefore(rec, accountID) {
+			continue
+		}
+		out.Changes = append(out.Changes, rec)
+	}
+	return &out, nil
+}
+
+// listableBefore reports whether the page of a deletion tombstone could be
+// listed by the viewer just before it was deleted. Collaborators are removed
+// with the page, so only owners and public pages qualify.
+func (s *server) listableBefore(rec *pages.PageChange, viewer string) bool {
+	ts := rec.Created - 1
+	page, err := s.state.PageVisibleAsOf(rec.PageId, viewer, ts)
+	if err != nil {
+		return false
+	}
+	return page.Account.Id == viewer || (page.Visibility == pages.Visibility_PUBLIC && state.PublishedAsOf(page, ts))
+}
+
+func (s *server) Sync(stream pages.Pages_SyncServer) error {
+	ctx := stream.Context()
+	accountID := s.authorizedAccountID(ctx)
+	in, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if in.Cursor < 0 {
+		return ErrInvalidCursor
+	}
+
+	// Requests are received on their own goroutine so changes made on the
+	// server can be sent while the client is idle.
+	reqs := make(chan *pages.SyncRequest)
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	sub := s.broker.Subscribe(watchBuffer, nil)
+	defer func() { s.broker.Unsubscribe(sub) }()
+	cursor := in.Cursor
+	for {
+		var results []*pages.SyncResult
+		if in != nil {
+			for _, change := range in.Changes {
+				results = append(results, s.syncChange(ctx, accountID, change))
+			}
+		}
+		if cursor, err = s.syncSend(stream, accountID, cursor, results); err != nil {
+			return err
+		}
+		in = nil
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errc:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case in = <-reqs:
+		case _, ok := <-sub.C:
+			// Changes are read from the cursor, so a subscription dropped
+			// for falling behind can be replaced without missing any.
+			if !ok {
+				sub = s.broker.Subscribe(watchBuffer, nil)
+			}
+		}
+	}
+}
+
+// syncSend sends the results of a sync request and the changes after cursor,
+// and returns the new cursor. Nothing is sent if there is nothing new.
+func (s *server) syncSend(stream pages.Pages_SyncServer, accountID string, cursor int64, results []*pages.SyncResult) (int64, error) {
+	for {
+		set, err := s.changes(accountID, cursor, maxChangesLimit)
+		if err != nil {
+			return cursor, err
+		}
+		cursor = set.Cursor
+		if len(results) > 0 || len(set.Changes) > 0 {
+			out := pages.SyncResponse{Results: results, Changes: set.Changes, Cursor: cursor}
+			if err := stream.Send(&out); err != nil {
+				return cursor, err
+			}
+		}
+		if !set.More {
+			return cursor, nil
+		}
+		results = nil
+	}
+}
+
+// syncChange applies a change pushed by Sync and returns its result.
+func (s *server) syncChange(ctx context.Context, accountID string, in *pages.SyncChange) *pages.SyncResult {
+	out := &pages.SyncResult{Ref: in.Ref}
+	var err error
+	switch in.Type {
+	case pages.PageEventType_CREATED:
+		out.Page, err = s.PageCreate(ctx, &pages.PageCreateRequest{Text: in.Text, Visibility: in.Visibility})
+	case pages.PageEventType_UPDATED:
+		out.Outcome, out.Page, err = s.syncUpdate(ctx, accountID, in)
+	case pages.PageEventType_DELETED:
+		out.Outcome, out.Page, err = s.syncDelete(ctx, accountID, in)
+	}
+	if err != nil {
+		out.Outcome, out.Error = pages.SyncOutcome_REJECTED, grpc.ErrorDesc(err)
+	}
+	return out
+}
+
+// syncUpdate applies a synced update, merging it with any changes made since
+// its base version. Updates that can't be merged, or are to pages that have
+// since been deleted or are locked by another account, are saved as a
+// conflict copy.
+func (s *server) syncUpdate(ctx context.Context, accountID string, in *pages.SyncChange) (pages.SyncOutcome, *pages.Page, error) {
+	role, err := s.state.PageRole(in.PageId, accountID)
+	if err == state.ErrPageNotFound {
+		page, err := s.syncCopy(ctx, in)
+		return pages.SyncOutcome_COPIED, page, err
+	} else if err != nil {
+		return pages.SyncOutcome_REJECTED, nil, err
+	}
+	if role < pages.Role_EDITOR {
+		return pages.SyncOutcome_REJECTED, nil, state.ErrPageUnauthorized
+	}
+	base, err := s.state.PageRevision(in.PageId, in.BaseVersion)
+	if err != nil {
+		return pages.SyncOutcome_REJECTED, nil, err
+	}
+	page, err := s.patchPage(ctx, accountID, in.PageId, in.BaseVersion, base, in.Text)
+	switch {
+	case err == ErrPatchConflict || err == ErrPageLocked:
+		page, err := s.syncCopy(ctx, in)
+		return pages.SyncOutcome_COPIED, page, err
+	case err != nil:
+		return pages.SyncOutcome_REJECTED, nil, err
+	case page.Version != in.BaseVersion+1:
+		return pages.SyncOutcome_MERGED, page, nil
+	}
+	return pages.SyncOutcome_APPLIED, page, nil
+}
+
+// syncCopy saves a synced update as a new page owned by the syncing account.
+func (s *server) syncCopy(ctx context.Context, in *pages.SyncChange) (*pages.Page, error) {
+	return s.PageCreate(ctx, &pages.PageCreateRequest{Text: in.Text, Visibility: in.Visibility})
+}
+
+// syncDelete applies a synced delete unless the page has changed since its
+// base version. Pages that are already deleted are left deleted.
+func (s *server) syncDelete(ctx context.Context, accountID string, in *pages.SyncChange) (pages.SyncOutcome, *pages.Page, error) {
+	page, err := s.state.Page(in.PageId)
+	if err == state.ErrPageNotFound {
+		return pages.SyncOutcome_APPLIED, nil, nil
+	} else if err != nil {
+		return pages.SyncOutcome_REJECTED, nil, err
+	}
+	if page.Version != in.BaseVersion && s.listable(page, accountID) {
+		return pages.SyncOutcome_REJECTED, page, ErrPageChanged
+	}
+	page, err = s.PageDelete(ctx, &pages.PageDeleteRequest{Id: in.PageId})
+	return pages.SyncOutcome_APPLIED, page, err
+}
+
+func (s *server) PageShare(ctx context.Context, in *pages.PageShareRequest) (*pages.CollaboratorsSet, error) {
+	if in.Email == "" {
+		return nil, ErrMissingEmail
+	}
+	if in.Role == pages.Role_NONE {
+		return nil, ErrMissingRole
+	}
+	accountID := s.authorizedAccountID(ctx)
+	if err := s.checkOwner(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	collaborator, err := s.state.AccountForEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	if collaborator.Id == accountID {
+		return nil, ErrInvalidCollaborator
+	}
+	if err := s.state.PageShare(in.Id, accountID, collaborator.Id, in.Role); err != nil {
+		return nil, err
+	}
+	return s.collaborators(in.Id)
+}
+
+func (s *server) PageUnshare(ctx context.Context, in *pages.PageUnshareRequest) (*pages.CollaboratorsSet, error) {
+	if in.Email == "" {
+		return nil, ErrMissingEmail
+	}
+	accountID := s.authorizedAccountID(ctx)
+	if err := s.checkOwner(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	collaborator, err := s.state.AccountForEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.PageUnshare(in.Id, accountID, collaborator.Id); err != nil {
+		return nil, err
+	}
+	return s.collaborators(in.Id)
+}
+
+// checkOwner checks that a page belongs to the account before anything is
+// looked up on its behalf, so sharing doesn't reveal which emails have
+// accounts to callers who can't share the page.
+func (s *server) checkOwner(id, accountID string) error {
+	role, err := s.state.PageRole(id, accountID)
+	if err != nil {
+		return err
+	}
+	if role != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	return nil
+}
+
+func (s *server) PageCollaborators(ctx context.Context, in *pages.PageCollaboratorsRequest) (*pages.CollaboratorsSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	role, err := s.state.PageRole(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if role == pages.Role_NONE {
+		return nil, state.ErrPageNotFound
+	}
+	return s.collaborators(in.Id)
+}
+
+func (s *server) PageLockAcquire(ctx context.Context, in *pages.PageLockRequest) (*pages.PageLock, error) {
+	expires, err := lockExpires(in.Ttl)
+	if err != nil {
+		return nil, err
+	}
+	accountID := s.authorizedAccountID(ctx)
+	lock, err := s.state.PageLockAcquire(in.Id, accountID, expires)
+	if err == state.ErrPageLocked {
+		return nil, ErrPageLocked
+	}
+	return lock, err
+}
+
+func (s *server) PageLockRenew(ctx context.Context, in *pages.PageLockRequest) (*pages.PageLock, error) {
+	expires, err := lockExpires(in.Ttl)
+	if err != nil {
+		return nil, err
+	}
+	accountID := s.authorizedAccountID(ctx)
+	lock, err := s.state.PageLockRenew(in.Id, accountID, expires)
+	if err == state.ErrLockNotHeld {
+		return nil, ErrLockNotHeld
+	}
+	return lock, err
+}
+
+func (s *server) PageLockRelease(ctx context.Context, in *pages.PageLockRequest) (*pages.Page, error) {
+	accountID := s.authorizedAccountID(ctx)
+	err := s.state.PageLockRelease(in.Id, accountID)
+	if err == state.ErrLockNotHeld {
+		return nil, ErrLockNotHeld
+	} else if err != nil {
+		return nil, err
+	}
+	return s.state.Page(in.Id)
+}
+
+// lockExpires returns when a lease of ttl seconds taken now expires. A ttl
+// of zero gives the default lease.
+func lockExpires(ttl int64) (int64, error) {
+	if ttl < 0 || ttl > int64(maxLockTTL/time.Second) {
+		return 0, ErrInvalidTTL
+	}
+	d := lockTTL
+	if ttl != 0 {
+		d = time.Duration(ttl) * time.Second
+	}
+	return time.Now().UTC().Add(d).UnixNano(), nil
+}
+
+// checkLock returns ErrPageLocked if another account holds the page's edit
+// lease.
+func (s *server) checkLock(id, accountID string) error {
+	lock, err := s.state.PageLock(id)
+	if err == state.ErrLockNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if lock.Account.Id != accountID {
+		return ErrPageLocked
+	}
+	return nil
+}
+
+func (s *server) PageBacklinks(ctx context.Context, in *pages.PageLinksRequest) (*pages.PageLinksSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	if _, err := s.state.PageVisible(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	links, err := s.state.PageBacklinks(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	out := &pages.PageLinksSet{}
+	for _, link := range links {
+		if s.listable(link.Page, accountID) {
+			out.Links = append(out.Links, link)
+		}
+	}
+	return out, nil
+}
+
+func (s *server) PageOutlinks(ctx context.Context, in *pages.PageLinksRequest) (*pages.PageLinksSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	if _, err := s.state.PageVisible(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	links, err := s.state.PageOutlinks(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		if link.Page != nil && !s.listable(link.Page, accountID) {
+			link.Page = nil
+		}
+	}
+	return &pages.PageLinksSet{Links: links}, nil
+}
+
+func (s *server) PageFork(ctx context.Context, in *pages.PageForkRequest) (*pages.Page, error) {
+	if in.Status == pages.PageStatus_SCHEDULED && in.PublishAt == 0 {
+		return nil, ErrMissingPublishAt
+	}
+	if in.Status == pages.PageStatus_QUARANTINED {
+		return nil, ErrQuarantineStatus
+	}
+	accountID := s.authorizedAccountID(ctx)
+	source, err := s.state.PageVisible(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkText(accountID, source.Text); err != nil {
+		return nil, quotaTrailer(ctx, err)
+	}
+	if err := s.checkPages(accountID, 0, 1); err != nil {
+		return nil, quotaTrailer(ctx, err)
+	}
+
+	// Copied attachments count against the storage of the forking account.
+	var size int64
+	for _, attachment := range source.Attachments {
+		size += attachment.Size
+	}
+	if size > 0 {
+		account, err := s.state.Account(accountID)
+		if err != nil {
+			return nil, err
+		}
+		_, stored, err := s.state.AccountUsage(accountID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.limitsFor(account).CheckStorage(stored, size); err != nil {
+			return nil, quotaTrailer(ctx, err)
+		}
+	}
+	d, err := s.moderate(accountID, "", source.Text)
+	if err != nil {
+		return nil, err
+	}
+	if d.Action != pages.ModerationAction_QUARANTINE {
+		return s.state.PageFork(in.Id, accountID, in.Status, in.PublishAt)
+	}
+	page, err := s.state.PageFork(in.Id, accountID, pages.PageStatus_QUARANTINED, in.PublishAt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.record(page.Id, accountID, source.Text, d, in.Status, in.PublishAt); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (s *server) PageForks(ctx context.Context, in *pages.PageForksRequest) (*pages.PagesSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	role, err := s.state.PageRole(in.Id, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if role == pages.Role_NONE {
+		return nil, state.ErrPageNotFound
+	}
+	if role != pages.Role_OWNER {
+		return nil, state.ErrPageUnauthorized
+	}
+	recs, err := s.state.PageForks(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	out := []*pages.Page{}
+	for _, rec := range recs {
+		if s.listable(rec, accountID) {
+			out = append(out, rec)
+		}
+	}
+	return &pages.PagesSet{
+		Pages: out,
+		Total: int64(len(out)),
+		Page:  1,
+	}, nil
+}
+
+// listable reports whether a page may be shown to the viewer in lists of
+// pages: it is public and published or the viewer has a role on it.
+func (s *server) listable(page *pages.Page, viewer string) bool {
+	if page.Visibility == pages.Visibility_PUBLIC && page.Status == pages.PageStatus_PUBLISHED {
+		return true
+	}
+	role, err := s.state.PageRole(page.Id, viewer)
+	return err == nil && role != pages.Role_NONE
+}
+
+func (s *server) PageStats(ctx context.Context, in *pages.PageStatsRequest) (*pages.PageStatsResult, error) {
+	days := in.Days
+	if days == 0 {
+		days = statsDays
+	}
+	if days < 0 || days > maxStatsDays {
+		return nil, ErrInvalidDays
+	}
+	accountID := s.authorizedAccountID(ctx)
+	since := state.ViewDay(time.Now().UTC().UnixNano()) - (days-1)*int64(24*time.Hour)
+	out := &pages.PageStatsResult{}
+	var (
+		buckets []*pages.PageViewBucket
+		err     error
+	)
+	if in.Id != "" {
+		role, err := s.state.PageRole(in.Id, accountID)
+		if err != nil {
+			return nil, err
+		}
+		if role != pages.Role_OWNER {
+			return nil, state.ErrPageUnauthorized
+		}
+		buckets, err = s.state.PageViews(in.Id, since)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		buckets, err = s.state.PageViewsForAccount(accountID, since)
+		if err != nil {
+			return nil, err
+		}
+		out.TopPages, err = s.state.PageViewsTop(accountID, since, statsTopPages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Days without views are filled in so clients can chart the range as is.
+	views := make(map[int64]int64)
+	for _, bucket := range buckets {
+		views[bucket.Day] = bucket.Views
+	}
+	for i := int64(0); i < days; i++ {
+		day := since + i*int64(24*time.Hour)
+		out.Days = append(out.Days, &pages.PageViewBucket{Day: day, Views: views[day]})
+		out.Total += views[day]
+	}
+	return out, nil
+}
+
+// viewer returns an opaque key identifying who is viewing a page: the
+// account when authenticated and otherwise the client's address.
+func (s *server) viewer(ctx context.Context, accountID string) string {
+	key := "account:" + accountID
+	if accountID == "" {
+		key = "addr:" + s.clientAddr(ctx)
+	}
+	sum := sha256.Sum256([]byte(s.viewSalt + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// clientAddr returns the client's IP address. Requests the gateway forwards
+// carry the address it was connected from as the last x-forwarded-for
+// entry, after any the client sent. Anyone else connecting directly could
+// claim any address, so they're known by their own.
+func (s *server) clientAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	if md, ok := metadata.FromContext(ctx); ok && len(md["x-forwarded-for"]) > 0 && s.gateways[canonicalIP(host)] {
+		fwd := md["x-forwarded-for"]
+		addrs := strings.Split(fwd[len(fwd)-1], ",")
+		return strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	return host
+}
+
+// canonicalIP returns addr in the standard form of the IP it names, so
+// IPv4 addresses match however they're written.
+func canonicalIP(addr string) string {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip.String()
+	}
+	return addr
+}
+
+// validators returns the etag and last-modified metadata of a read of the
+// resource named key by an account, which last changed with change. Changes
+// are stored, so validators outlast restarts. Etags differ between accounts
+// so one account's copy never validates another's.
+func validators(key, accountID string, change *pages.PageChange) metadata.MD {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d", key, accountID, change.Sequence)))
+	return metadata.Pairs(
+		"etag", `"`+hex.EncodeToString(sum[:])+`"`,
+		"last-modified", time.Unix(0, change.Created).UTC().Format(http.TimeFormat),
+	)
+}
+
+// notModified reports whether the if-none-match or if-modified-since
+// metadata of a conditional read shows the client's copy matches header.
+// As in HTTP, if-modified-since is ignored when if-none-match is given.
+func notModified(ctx context.Context, header metadata.MD) bool {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	if match := md["if-none-match"]; len(match) > 0 {
+		for _, tag := range strings.Split(match[0], ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == header["etag"][0] {
+				return true
+			}
+		}
+		return false
+	}
+	if since := md["if-modified-since"]; len(since) > 0 {
+		t, err := http.ParseTime(since[0])
+		if err != nil {
+			return false
+		}
+		modified, _ := http.ParseTime(header["last-modified"][0])
+		return !modified.After(t)
+	}
+	return false
+}
+
+func (s *server) PageWatch(in *pages.PageWatchRequest, stream pages.Pages_PageWatchServer) error {
+	ctx := stream.Context()
+	accountID := s.authorizedAccountID(ctx)
+	sub := s.broker.Subscribe(watchBuffer, func(e *broker.Event) bool {
+		if in.Id != "" && e.Page.Id != in.Id {
+			return false
+		}
+		if in.AccountId != "" && e.Page.Account.Id != in.AccountId {
+			return false
+		}
+		return true
+	})
+	defer s.broker.Unsubscribe(sub)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.C:
+			if !ok {
+				return ErrWatchBehind
+			}
+			if !s.eventVisible(e, accountID, in.Id != "") {
+				continue
+			}
+			if err := stream.Send(e.PageEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// eventVisible reports whether the viewer may see a page event. Unlisted
+// pages are only visible when watched directly by ID and unpublished pages
+// only to their author. Deleted pages can't be asked about, so their events
+// are checked against the roles they were published with.
+func (s *server) eventVisible(e *broker.Event, viewer string, direct bool) bool {
+	if e.Page.Status != pages.PageStatus_PUBLISHED {
+		return viewer != "" && e.Page.Account.Id == viewer
+	}
+	switch e.Page.Visibility {
+	case pages.Visibility_PUBLIC:
+		return true
+	case pages.Visibility_UNLISTED:
+		if direct {
+			return true
+		}
+	}
+	if viewer == "" {
+		return false
+	}
+	if e.Page.Account.Id == viewer {
+		return true
+	}
+	if e.Type == pages.PageEventType_DELETED {
+		return e.Roles[viewer] != pages.Role_NONE
+	}
+	role, err := s.state.PageRole(e.Page.Id, viewer)
+	return err == nil && role != pages.Role_NONE
+}
+
+func (s *server) AttachmentUpload(stream pages.Pages_AttachmentUploadServer) error {
+	accountID := s.authorizedAccountID(stream.Context())
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return ErrMissingAttachment
+	} else if err != nil {
+		return err
+	}
+	if first.Name == "" {
+		return ErrMissingFilename
+	}
+	if first.Size > s.maxAttachmentSize {
+		return ErrAttachmentTooLarge
+	}
+	role, err := s.state.PageRole(first.PageId, accountID)
+	if err != nil {
+		return err
+	}
+	if role < pages.Role_EDITOR {
+		return state.ErrPageUnauthorized
+	}
+
+	// Attachments count against the storage of the page's owner.
+	page, err := s.state.Page(first.PageId)
+	if err != nil {
+		return err
+	}
+	owner, err := s.state.Account(page.Account.Id)
+	if err != nil {
+		return err
+	}
+	_, stored, err := s.state.AccountUsage(owner.Id)
+	if err != nil {
+		return err
+	}
+	limits := s.limitsFor(owner)
+	if err := limits.CheckStorage(stored, first.Size); err != nil {
+		return quotaTrailer(stream.Context(), err)
+	}
+	r := &sizeLimiter{r: &chunkReader{stream: stream, buf: first.Data}, remaining: s.maxAttachmentSize, err: ErrAttachmentTooLarge}
+	if remaining := quota.Remaining(stored, limits.Storage); remaining >= 0 && remaining < s.maxAttachmentSize {
+		r.remaining, r.err = remaining, &quota.ExceededError{Resource: quota.Storage, Used: stored, Limit: limits.Storage}
+	}
+	hash, size, err := s.blobs.Put(r)
+	if err != nil {
+		return quotaTrailer(stream.Context(), err)
+	}
+	contentType := first.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(first.Name))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	attachment, err := s.state.AttachmentCreate(first.PageId, accountID, filepath.Base(first.Name), contentType, hash, size)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(attachment)
+}
+
+func (s *server) AttachmentDownload(in *pages.AttachmentDownloadRequest, stream pages.Pages_AttachmentDownloadServer) error {
+	attachment, err := s.state.Attachment(in.Id)
+	if err != nil {
+		return err
+	}
+	if _, err := s.state.PageVisible(attachment.PageId, s.authorizedAccountID(stream.Context())); err != nil {
+		return state.ErrAttachmentNotFound
+	}
+	blob, err := s.blobs.Get(attachment.Sha256)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	chunk := &pages.AttachmentChunk{
+		PageId:      attachment.PageId,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+	}
+	sent := false
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := blob.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &pages.AttachmentChunk{}
+			sent = true
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !sent {
+		return stream.Send(chunk)
+	}
+	return nil
+}
+
+func (s *server) TemplateCreate(ctx context.Context, in *pages.TemplateCreateRequest) (*pages.Template, error) {
+	if in.Name == "" {
+		return nil, ErrMissingName
+	}
+	if in.Text == "" {
+		return nil, ErrMissingText
+	}
+	accountID := s.authorizedAccountID(ctx)
+	return s.state.TemplateCreate(accountID, in.Name, in.Text)
+}
+
+func (s *server) TemplateList(ctx context.Context, in *pages.Empty) (*pages.TemplatesSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	recs, err := s.state.TemplatesForAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &pages.TemplatesSet{Templates: recs}, nil
+}
+
+func (s *server) TemplateDelete(ctx context.Context, in *pages.TemplateDeleteRequest) (*pages.Template, error) {
+	accountID := s.authorizedAccountID(ctx)
+	tmpl, err := s.state.Template(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.TemplateDelete(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// chunkReader reads the data of an attachment upload stream, starting with
+// any data already received in buf.
+type chunkReader struct {
+	stream pages.Pages_AttachmentUploadServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// sizeLimiter fails reads once more than remaining bytes have been read.
+type sizeLimiter struct {
+	r         io.Reader
+	remaining int64
+	err       error
+}
+
+func (l *sizeLimiter) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, l.err
+	}
+	return n, err
+}
+
+// merge spreads the results of the items passed on to state back over the
+// errors of the items rejected before it.
+func merge(errs []error, results []*pages.Page, resultErrs []error) ([]*pages.Page, []error) {
+	out := make([]*pages.Page, len(errs))
+	j := 0
+	for i := range errs {
+		if errs[i] != nil {
+			continue
+		}
+		out[i], errs[i] = results[j], resultErrs[j]
+		j++
+	}
+	return out, errs
+}
+
+// batchResult converts per-item batch outcomes into a batch result.
+func batchResult(results []*pages.Page, errs []error, atomic bool) *pages.PageBatchResult {
+	out := &pages.PageBatchResult{Committed: true}
+	for i, err := range errs {
+		item := &pages.PageBatchItem{}
+		switch {
+		case err == state.ErrBatchAborted:
+			item.Code = int32(codes.Aborted)
+			item.Error = err.Error()
+		case err != nil:
+			item.Code = int32(grpc.Code(err))
+			item.Error = grpc.ErrorDesc(err)
+		default:
+			item.Page = results[i]
+		}
+		if err != nil && atomic {
+			out.Committed = false
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out
+}
+
+// applyPatch applies a patch request's diff or operations to text. Text is
+// converted to code points once for all of the operations.
+func applyPatch(text string, in *pages.PagePatchRequest) (string, error) {
+	if in.Diff != "" {
+		return patch.Apply(text, in.Diff)
+	}
+	r := []rune(text)
+	var err error
+	for _, op := range in.Ops {
+		// Offsets and lengths too large for an int are past the end of any
+		// text.
+		if int64(int(op.Offset)) != op.Offset || int64(int(op.Length)) != op.Length {
+			return "", patch.ErrOutOfRange
+		}
+		switch op.Type {
+		case pages.TextOpType_INSERT:
+			r, err = patch.Splice(r, int(op.Offset), 0, op.Text)
+		case pages.TextOpType_DELETE:
+			r, err = patch.Splice(r, int(op.Offset), int(op.Length), "")
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(r), nil
+}
+
+// pageText returns the text of a new page, expanding the account's template
+// when the request names one.
+func (s *server) pageText(accountID string, in *pages.PageCreateRequest) (string, error) {
+	if in.TemplateId == "" {
+		if in.Text == "" {
+			return "", ErrMissingText
+		}
+		return in.Text, nil
+	}
+	if in.Text != "" {
+		return "", ErrTemplateWithText
+	}
+	tmpl, err := s.state.Template(in.TemplateId)
+	if err != nil {
+		return "", err
+	}
+	if tmpl.Account.Id != accountID {
+		return "", state.ErrTemplateNotFound
+	}
+	vars := template.Builtins(tmpl.Account.Name, time.Now().UTC())
+	for name, value := range in.Variables {
+		vars[name] = value
+	}
+	text, err := template.Expand(tmpl.Text, vars)
+	if err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if text == "" {
+		return "", ErrMissingText
+	}
+	return text, nil
+}
+
+// updateFields returns the fields an update changes. Every field in its mask
+// must be updatable and updated text can't be empty.
+func updateFields(in *pages.PageUpdateRequest) ([]string, error) {
+	fields := state.RequestFields(in)
+	for _, field := range fields {
+		if !state.HasField(state.UpdateFields, field) {
+			return nil, ErrInvalidUpdateMask
+		}
+	}
+	if state.HasField(fields, state.FieldText) && in.Text == "" {
+		return nil, ErrMissingText
+	}
+	return fields, nil
+}
+
+// limitsFor returns the quota limits of the account's plan.
+func (s *server) limitsFor(account *pages.Account) quota.Limits {
+	if limits, ok := s.plans[account.Plan]; ok {
+		return limits
+	}
+	return s.limits
+}
+
+// checkPages checks that n new pages fit in an account's quota along with
+// the pages it owns and pending pages not yet created.
+func (s *server) checkPages(accountID string, pending, n int) error {
+	account, err := s.state.Account(accountID)
+	if err != nil {
+		return err
+	}
+	count, _, err := s.state.AccountUsage(accountID)
+	if err != nil {
+		return err
+	}
+	return s.limitsFor(account).CheckPages(count+int64(pending), int64(n))
+}
 
-	// ErrMissingName means the account name is missing.
-	ErrMissingName = grpc.Errorf(codes.InvalidArgument, "Missing name")
+// checkText checks that text fits in a page owned by the account.
+func (s *server) checkText(ownerID, text string) error {
+	account, err := s.state.Account(ownerID)
+	if err != nil {
+		return err
+	}
+	return s.limitsFor(account).CheckText(text)
+}
 
-	// ErrMissingEmail means the account email address is missing.
-	ErrMissingEmail = grpc.Errorf(codes.InvalidArgument, "Missing email address")
+// checkPageText checks that text fits in an existing page. Missing pages
+// pass so the error reported is the one from updating them.
+func (s *server) checkPageText(id, text string) error {
+	page, err := s.state.Page(id)
+	if err != nil {
+		return nil
+	}
+	return s.checkText(page.Account.Id, text)
+}
 
-	// ErrMissingPassword means the account password is missing.
-	ErrMissingPassword = grpc.Errorf(codes.InvalidArgument, "Missing password")
+// quotaError converts a quota.ExceededError to a ResourceExhausted error.
+// Other errors are returned unchanged.
+func quotaError(err error) error {
+	if _, ok := err.(*quota.ExceededError); ok {
+		return grpc.Errorf(codes.ResourceExhausted, "%v", err)
+	}
+	return err
+}
 
-	// ErrMissingText means the page text is missing.
-	ErrMissingText = grpc.Errorf(codes.InvalidArgument, "Missing text")
-)
+// quotaTrailer is quotaError for errors that end a call. The quota's details
+// are also sent as trailer metadata so clients needn't parse the message.
+func quotaTrailer(ctx context.Context, err error) error {
+	if e, ok := err.(*quota.ExceededError); ok {
+		grpc.SetTrailer(ctx, metadata.Pairs(
+			"quota-resource", e.Resource,
+			"quota-used", strconv.FormatInt(e.Used, 10),
+			"quota-limit", strconv.FormatInt(e.Limit, 10),
+		))
+	}
+	return quotaError(err)
+}
 
-type server struct {
-	state state.State
+// moderate runs text an account is writing through the moderation
+// checkers. Rejected writes are recorded for review and fail. Writes to an
+// existing page are only recorded if the account can edit it, and pageID
+// is empty for pages that are being created.
+func (s *server) moderate(accountID, pageID, text string) (moderation.Decision, error) {
+	d := s.moderator.Check(text)
+	if d.Action != pages.ModerationAction_REJECT {
+		return d, nil
+	}
+	if pageID != "" {
+		role, err := s.state.PageRole(pageID, accountID)
+		if err != nil {
+			return d, err
+		}
+		if role < pages.Role_EDITOR {
+			return d, state.ErrPageUnauthorized
+		}
+	}
+	if _, err := s.record(pageID, accountID, text, d, pages.PageStatus_PUBLISHED, 0); err != nil {
+		return d, err
+	}
+	return d, grpc.Errorf(codes.PermissionDenied, "Page rejected by moderation: %s", d.Reason)
 }
 
-// Accounts Server
+// record stores a moderation decision on text an account wrote for admins
+// to review. Quarantined pages are restored to status and publish at when
+// approved.
+func (s *server) record(pageID, accountID, text string, d moderation.Decision, status pages.PageStatus, publishAt int64) (*pages.ModerationDecision, error) {
+	return s.state.ModerationRecord(&pages.ModerationDecision{
+		PageId:    pageID,
+		Account:   &pages.Account{Id: accountID},
+		Action:    d.Action,
+		Checker:   d.Checker,
+		Reason:    d.Reason,
+		Text:      text,
+		Status:    status,
+		PublishAt: publishAt,
+	})
+}
 
-func (s *server) Register(ctx context.Context, in *pages.RegisterRequest) (*pages.Session, error) {
-	if in.Name == "" {
-		return nil, ErrMissingName
+// quarantine holds an existing page for review once an account has written
+// text to it.
+func (s *server) quarantine(id, accountID, text string, d moderation.Decision) error {
+	role, err := s.state.PageRole(id, accountID)
+	if err != nil {
+		return err
 	}
-	if in.Email == "" {
-		return nil, ErrMissingEmail
+	if role < pages.Role_EDITOR {
+		return state.ErrPageUnauthorized
 	}
-	if in.Password == "" {
-		return nil, ErrMissingPassword
+	page, err := s.state.Page(id)
+	if err != nil {
+		return err
 	}
-	account, err := s.state.AccountCreate(in.Name, in.Email, in.Password)
+	status, publishAt, err := s.hold(page)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	token, err := s.state.AccountTokenSet(account.Id)
+	_, err = s.record(id, accountID, text, d, status, publishAt)
+	return err
+}
+
+// hold quarantines a page and returns the status it was held from. Pages
+// that are already quarantined stay held from their original status.
+func (s *server) hold(page *pages.Page) (pages.PageStatus, int64, error) {
+	if page.Status == pages.PageStatus_QUARANTINED {
+		return s.heldStatus(page.Id)
+	}
+	if _, err := s.state.PageStatusUpdate(page.Id, page.Account.Id, pages.PageStatus_QUARANTINED, page.PublishAt); err != nil {
+		return 0, 0, err
+	}
+	return page.Status, page.PublishAt, nil
+}
+
+// heldStatus returns the status a quarantined page was held from, as
+// recorded by its latest quarantine decision.
+func (s *server) heldStatus(id string) (pages.PageStatus, int64, error) {
+	decisions, err := s.state.ModerationDecisions(id, false)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
-	session := &pages.Session{Account: account, Token: token}
-	return session, nil
+	for _, d := range decisions {
+		if d.Action == pages.ModerationAction_QUARANTINE {
+			return d.Status, d.PublishAt, nil
+		}
+	}
+	return pages.PageStatus_DRAFT, 0, nil
 }
 
-func (s *server) Connect(ctx context.Context, in *pages.ConnectRequest) (*pages.Session, error) {
-	if in.Identifier == "" {
-		return nil, ErrMissingEmail
+// release restores a quarantined page to the status it was held from once
+// none of its quarantine decisions are pending and the latest was approved.
+func (s *server) release(id string) error {
+	page, err := s.state.Page(id)
+	if err == state.ErrPageNotFound {
+		return nil
+	} else if err != nil {
+		return err
 	}
-	if in.Password == "" {
-		return nil, ErrMissingPassword
+	if page.Status != pages.PageStatus_QUARANTINED {
+		return nil
 	}
-	account, err := s.state.AccountForEmail(in.Identifier)
+	decisions, err := s.state.ModerationDecisions(id, false)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if _, err = s.state.AccountForPassword(account.Id, in.Password); err != nil {
-		return nil, err
+	var latest *pages.ModerationDecision
+	for _, d := range decisions {
+		if d.Action != pages.ModerationAction_QUARANTINE {
+			continue
+		}
+		if d.Verdict == pages.ReviewVerdict_PENDING {
+			return nil
+		}
+		if latest == nil {
+			latest = d
+		}
 	}
-	token, err := s.state.AccountTokenSet(account.Id)
+	if latest == nil || latest.Verdict != pages.ReviewVerdict_NOT_SPAM {
+		return nil
+	}
+	_, err = s.state.PageStatusUpdate(id, page.Account.Id, latest.Status, latest.PublishAt)
+	return err
+}
+
+// publishScheduled publishes scheduled pages as they come due and expires
+// abandoned edit leases. Pages whose time passed while the server was down
+// are published on the first check.
+func (s *server) publishScheduled() {
+	for range time.Tick(scheduleInterval) {
+		if _, err := s.state.PagePublishDue(time.Now().UTC().UnixNano()); err != nil {
+			grpclog.Printf("Failed to publish scheduled pages: %v", err)
+		}
+		if _, err := s.state.PageLocksExpire(time.Now().UTC().UnixNano()); err != nil {
+			grpclog.Printf("Failed to expire page locks: %v", err)
+		}
+	}
+}
+
+func (s *server) collaborators(id string) (*pages.CollaboratorsSet, error) {
+	recs, err := s.state.PageCollaborators(id)
 	if err != nil {
 		return nil, err
 	}
-	session := &pages.Session{Account: account, Token: token}
-	return session, nil
+	return &pages.CollaboratorsSet{Collaborators: recs}, nil
 }
 
-// Pages Server
+// Comments Server
 
-func (s *server) PageCreate(ctx context.Context, in *pages.PageCreateRequest) (*pages.Page, error) {
+func (s *server) CommentCreate(ctx context.Context, in *pages.CommentCreateRequest) (*pages.Comment, error) {
 	if in.Text == "" {
 		return nil, ErrMissingText
 	}
+	if in.ParentId != "" && in.Anchor != nil {
+		return nil, ErrAnchoredReply
+	}
 	accountID := s.authorizedAccountID(ctx)
-	return s.state.PageCreate(accountID, in.Text)
+	role, err := s.state.PageRole(in.PageId, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if role == pages.Role_NONE {
+		return nil, state.ErrPageUnauthorized
+	}
+	if in.Anchor != nil {
+		text, err := s.state.PageRevision(in.PageId, in.Anchor.Version)
+		if err != nil {
+			return nil, err
+		}
+		if in.Anchor.Start < 0 || in.Anchor.Start > in.Anchor.End || in.Anchor.End > int64(utf8.RuneCountInString(text)) {
+			return nil, ErrInvalidAnchor
+		}
+	}
+	return s.state.CommentCreate(in.PageId, accountID, in.ParentId, in.Text, in.Anchor)
 }
 
-func (s *server) PageUpdate(ctx context.Context, in *pages.PageUpdateRequest) (*pages.Page, error) {
+func (s *server) CommentUpdate(ctx context.Context, in *pages.CommentUpdateRequest) (*pages.Comment, error) {
 	if in.Text == "" {
 		return nil, ErrMissingText
 	}
 	accountID := s.authorizedAccountID(ctx)
-	return s.state.PageUpdate(in.Id, accountID, in.Text)
+	return s.state.CommentUpdate(in.Id, accountID, in.Text)
 }
 
-func (s *server) PageDelete(ctx context.Context, in *pages.PageDeleteRequest) (*pages.Page, error) {
+func (s *server) CommentDelete(ctx context.Context, in *pages.CommentDeleteRequest) (*pages.Comment, error) {
 	accountID := s.authorizedAccountID(ctx)
+	return s.state.CommentDelete(in.Id, accountID)
+}
+
+func (s *server) CommentList(ctx context.Context, in *pages.CommentListRequest) (*pages.CommentsSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	if _, err := s.state.PageVisible(in.PageId, accountID); err != nil {
+		return nil, err
+	}
+	recs, err := s.state.Comments(in.PageId)
+	if err != nil {
+		return nil, err
+	}
+	return &pages.CommentsSet{Comments: recs}, nil
+}
+
+// Moderation Server
+
+func (s *server) ModerationList(ctx context.Context, in *pages.ModerationListRequest) (*pages.ModerationDecisionsSet, error) {
+	if !s.admins[s.authorizedAccountID(ctx)] {
+		return nil, ErrAdminOnly
+	}
+	recs, err := s.state.ModerationDecisions(in.PageId, in.Pending)
+	if err != nil {
+		return nil, err
+	}
+	return &pages.ModerationDecisionsSet{Decisions: recs}, nil
+}
+
+func (s *server) ModerationReview(ctx context.Context, in *pages.ModerationReviewRequest) (*pages.ModerationDecision, error) {
+	adminID := s.authorizedAccountID(ctx)
+	if !s.admins[adminID] {
+		return nil, ErrAdminOnly
+	}
+	if in.Verdict == pages.ReviewVerdict_PENDING {
+		return nil, ErrMissingVerdict
+	}
+	decision, err := s.state.ModerationDecision(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if decision.Verdict != pages.ReviewVerdict_PENDING {
+		return nil, ErrAlreadyReviewed
+	}
+	decision, err = s.state.ModerationReview(in.Id, adminID, in.Verdict)
+	if err != nil {
+		return nil, err
+	}
+	s.classifier.Train(decision.Text, decision.Verdict == pages.ReviewVerdict_SPAM)
+	if decision.Action == pages.ModerationAction_QUARANTINE && decision.Verdict == pages.ReviewVerdict_NOT_SPAM {
+		if err := s.release(decision.PageId); err != nil {
+			return nil, err
+		}
+	}
+	return decision, nil
+}
+
+func (s *server) PageFlag(ctx context.Context, in *pages.PageFlagRequest) (*pages.ModerationDecision, error) {
+	adminID := s.authorizedAccountID(ctx)
+	if !s.admins[adminID] {
+		return nil, ErrAdminOnly
+	}
 	page, err := s.state.Page(in.Id)
 	if err != nil {
 		return nil, err
 	}
-	if err := s.state.PageDelete(in.Id, accountID); err != nil {
+	status, publishAt, err := s.hold(page)
+	if err != nil {
 		return nil, err
 	}
-	return page, nil
+	decision, err := s.state.ModerationRecord(&pages.ModerationDecision{
+		PageId:     page.Id,
+		Account:    page.Account,
+		Action:     pages.ModerationAction_QUARANTINE,
+		Checker:    "admin",
+		Reason:     in.Reason,
+		Text:       page.Text,
+		Status:     status,
+		PublishAt:  publishAt,
+		Verdict:    pages.ReviewVerdict_SPAM,
+		ReviewerId: adminID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.classifier.Train(page.Text, true)
+	return decision, nil
 }
 
-func (s *server) PageGet(ctx context.Context, in *pages.PageGetRequest) (*pages.Page, error) {
-	return s.state.Page(in.Id)
+// Webhooks Server
+
+func (s *server) WebhookCreate(ctx context.Context, in *pages.WebhookCreateRequest) (*pages.Webhook, error) {
+	if !webhook.ValidURL(in.Url, s.webhookPrivate) {
+		return nil, ErrInvalidWebhookURL
+	}
+	secret := in.Secret
+	if secret == "" {
+		secret = utils.RandSha1()
+	}
+	var events []pages.PageEventType
+	for _, event := range in.Events {
+		if !subscribed(events, event) {
+			events = append(events, event)
+		}
+	}
+	accountID := s.authorizedAccountID(ctx)
+	return s.state.WebhookCreate(accountID, in.Url, secret, events)
+}
+
+func (s *server) WebhookList(ctx context.Context, in *pages.Empty) (*pages.WebhooksSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	recs, err := s.state.WebhooksForAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+	out := &pages.WebhooksSet{}
+	for _, rec := range recs {
+		out.Webhooks = append(out.Webhooks, withoutSecret(rec))
+	}
+	return out, nil
 }
 
-func (s *server) PageList(ctx context.Context, in *pages.Empty) (*pages.PagesSet, error) {
-	recs, err := s.state.Pages()
+func (s *server) WebhookDelete(ctx context.Context, in *pages.WebhookDeleteRequest) (*pages.Webhook, error) {
+	accountID := s.authorizedAccountID(ctx)
+	hook, err := s.state.Webhook(in.Id)
 	if err != nil {
 		return nil, err
 	}
-	out := pages.PagesSet{
+	if err := s.state.WebhookDelete(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	return withoutSecret(hook), nil
+}
+
+func (s *server) WebhookDeliveries(ctx context.Context, in *pages.WebhookDeliveriesRequest) (*pages.WebhookDeliveriesSet, error) {
+	limit := in.Limit
+	if limit == 0 {
+		limit = webhookDeliveriesLimit
+	}
+	if limit < 0 || limit > maxWebhookDeliveriesLimit {
+		return nil, ErrInvalidLimit
+	}
+	accountID := s.authorizedAccountID(ctx)
+	hook, err := s.state.Webhook(in.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+	if hook.Account.Id != accountID {
+		return nil, state.ErrWebhookNotFound
+	}
+	recs, err := s.state.WebhookDeliveries(in.WebhookId, int(limit))
+	if err != nil {
+		return nil, err
+	}
+	return &pages.WebhookDeliveriesSet{Deliveries: recs}, nil
+}
+
+// queueWebhooks queues a delivery of every page event to each webhook of
+// the page's owner that subscribes to it. Events are read from state after
+// the stored cursor, so events recorded while queueing falls behind or the
+// server is stopped are queued once it catches up. Published events only
+// wake it early.
+func (s *server) queueWebhooks() {
+	sub := s.broker.Subscribe(webhookBuffer, nil)
+	tick := time.Tick(webhookInterval)
+	for {
+		if err := s.queueEvents(); err != nil {
+			grpclog.Printf("Failed to queue webhooks: %v", err)
+		}
+		select {
+		case <-tick:
+		case _, ok := <-sub.C:
+			if !ok {
+				sub = s.broker.Subscribe(webhookBuffer, nil)
+			}
+		}
+	}
+}
+
+// queueEvents queues deliveries for the page events after the webhook
+// cursor, moving the cursor past each event once it's queued.
+func (s *server) queueEvents() error {
+	cursor, err := s.state.WebhookCursor()
+	if err != nil {
+		return err
+	}
+	for {
+		events, err := s.state.PageEvents(cursor, webhookBatch)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if e.Page != nil {
+				if err := s.queueEvent(&pages.PageEvent{Type: e.Type, Page: e.Page, Created: e.Created}); err != nil {
+					return err
+				}
+			}
+			if err := s.state.WebhookCursorSet(e.Sequence); err != nil {
+				return err
+			}
+			cursor = e.Sequence
+		}
+		if len(events) < webhookBatch {
+			return nil
+		}
+	}
+}
+
+func (s *server) queueEvent(e *pages.PageEvent) error {
+	hooks, err := s.state.WebhooksForAccount(e.Page.Account.Id)
+	if err != nil {
+		return err
+	}
+	var payload string
+	for _, hook := range hooks {
+		if len(hook.Events) > 0 && !subscribed(hook.Events, e.Type) {
+			continue
+		}
+		if payload == "" {
+			if payload, err = webhook.Payload(e); err != nil {
+				grpclog.Printf("Failed to encode webhook payload: %v", err)
+				return nil
+			}
+		}
+		if _, err := s.state.WebhookDeliveryCreate(hook.Id, e.Type, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliverWebhooks attempts queued webhook deliveries as they come due.
+// Deliveries queued before the server stopped are attempted once it's back.
+func (s *server) deliverWebhooks() {
+	for range time.Tick(webhookInterval) {
+		due, err := s.state.WebhookDeliveriesDue(time.Now().UTC().UnixNano(), webhookBatch)
+		if err != nil {
+			grpclog.Printf("Failed to read webhook deliveries: %v", err)
+			continue
+		}
+		var wg sync.WaitGroup
+		for _, d := range due {
+			wg.Add(1)
+			go func(d *pages.WebhookDelivery) {
+				defer wg.Done()
+				s.deliver(d)
+			}(d)
+		}
+		wg.Wait()
+	}
+}
+
+// deliver attempts a webhook delivery and records the outcome. Failed
+// deliveries are retried with exponential backoff until they run out of
+// attempts.
+func (s *server) deliver(d *pages.WebhookDelivery) {
+	hook, err := s.state.Webhook(d.WebhookId)
+	if err != nil {
+		grpclog.Printf("Failed to deliver webhook: %v", err)
+		return
+	}
+	code, err := webhook.Deliver(s.webhookClient, hook.Url, hook.Secret, d)
+	d.Attempts++
+	d.ResponseCode = int32(code)
+	d.Error = ""
+	switch {
+	case err == nil:
+		d.Status = pages.DeliveryStatus_DELIVERED
+	case d.Attempts >= s.webhookAttempts:
+		d.Status = pages.DeliveryStatus_FAILED
+		d.Error = err.Error()
+	default:
+		d.Error = err.Error()
+		d.NextAttempt = time.Now().UTC().Add(webhook.Backoff(d.Attempts, s.webhookBackoff, maxWebhookBackoff)).UnixNano()
+	}
+	if err := s.state.WebhookDeliveryUpdate(d); err != nil {
+		grpclog.Printf("Failed to record webhook delivery: %v", err)
+	}
+}
+
+// subscribed reports whether events includes event.
+func subscribed(events []pages.PageEventType, event pages.PageEventType) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutSecret returns a copy of a webhook without its secret, which is
+// only shown when the webhook is created.
+func withoutSecret(hook *pages.Webhook) *pages.Webhook {
+	out := *hook
+	out.Secret = ""
+	return &out
+}
+
+// Notebooks Server
+
+func (s *server) NotebookCreate(ctx context.Context, in *pages.NotebookCreateRequest) (*pages.Notebook, error) {
+	if in.Name == "" {
+		return nil, ErrMissingName
+	}
+	accountID := s.authorizedAccountID(ctx)
+	return s.state.NotebookCreate(accountID, in.Name, in.ParentId)
+}
+
+func (s *server) NotebookList(ctx context.Context, in *pages.NotebookListRequest) (*pages.NotebooksSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	recs, err := s.state.Notebooks(accountID, in.ParentId, in.Recursive)
+	if err != nil {
+		return nil, err
+	}
+	return &pages.NotebooksSet{Notebooks: recs}, nil
+}
+
+func (s *server) NotebookRename(ctx context.Context, in *pages.NotebookRenameRequest) (*pages.Notebook, error) {
+	if in.Name == "" {
+		return nil, ErrMissingName
+	}
+	accountID := s.authorizedAccountID(ctx)
+	return s.state.NotebookRename(in.Id, accountID, in.Name)
+}
+
+func (s *server) NotebookMove(ctx context.Context, in *pages.NotebookMoveRequest) (*pages.Notebook, error) {
+	accountID := s.authorizedAccountID(ctx)
+	return s.state.NotebookMove(in.Id, accountID, in.ParentId, in.Position)
+}
+
+func (s *server) NotebookDelete(ctx context.Context, in *pages.NotebookDeleteRequest) (*pages.Notebook, error) {
+	accountID := s.authorizedAccountID(ctx)
+	notebook, err := s.state.Notebook(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.state.NotebookDelete(in.Id, accountID); err != nil {
+		return nil, err
+	}
+	return notebook, nil
+}
+
+func (s *server) NotebookPages(ctx context.Context, in *pages.NotebookPagesRequest) (*pages.PagesSet, error) {
+	accountID := s.authorizedAccountID(ctx)
+	notebook, err := s.state.Notebook(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if notebook.Account.Id != accountID {
+		return nil, state.ErrNotebookNotFound
+	}
+	recs, err := s.state.NotebookPages(in.Id, in.Recursive)
+	if err != nil {
+		return nil, err
+	}
+	return &pages.PagesSet{
 		Pages: recs,
 		Total: int64(len(recs)),
 		Page:  1,
+	}, nil
+}
+
+func (s *server) PageMove(ctx context.Context, in *pages.PageMoveRequest) (*pages.Page, error) {
+	accountID := s.authorizedAccountID(ctx)
+	if err := s.state.PageMove(in.Id, accountID, in.NotebookId, in.Position); err != nil {
+		return nil, err
 	}
-	return &out, nil
+	return s.state.Page(in.Id)
 }
 
 // Auth
 
+// authedStream carries an authenticated context into stream handlers.
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
 func (s *server) authStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	// TODO: Unclear how to pass new authenticated context into the stream handelr...
-	if _, err := s.authorize(stream.Context()); err != nil {
+	authedCtx, err := s.authorize(stream.Context())
+	if err != nil {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
 		return err
 	}
-	return handler(srv, stream)
+	return handler(srv, &authedStream{stream, authedCtx})
 }
 
 func (s *server) authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	public := map[string]bool{
-		"/Accounts/Register": true,
-		"/Accounts/Connect":  true,
-		"/Pages/PageList":    true,
-		"Pages/PageGet":      true,
-	}
-	if _, ok := public[info.FullMethod]; ok {
+	if publicMethods[info.FullMethod] {
+		// Public methods still identify the caller when a valid token is
+		// provided so they can include the caller's own private pages.
+		if authedCtx, err := s.authorize(ctx); err == nil {
+			ctx = authedCtx
+		}
 		return handler(ctx, req)
 	}
 	authedCtx, err := s.authorize(ctx)
@@ -212,13 +2594,97 @@ func main() {
 	proxyPort := utils.GetenvInt("SERVER_PROXY_PORT", 8081)
 	debug := utils.GetenvBool("DEBUG", true) // Runs on server host port 8082
 	stateBackend := utils.GetenvString("SERVER_STATE", "memory")
+	blobRoot := utils.GetenvString("SERVER_BLOBS", "/tmp/blobs")
+	maxAttachmentSize := utils.GetenvInt("SERVER_MAX_ATTACHMENT_SIZE", 10<<20) // 10MB
+	feedLimit := utils.GetenvInt("SERVER_FEED_LIMIT", 20)
+	maxFeedLimit := utils.GetenvInt("SERVER_FEED_MAX_LIMIT", 100)
+	maxPages := utils.GetenvInt("SERVER_QUOTA_PAGES", 10000)
+	maxTextBytes := utils.GetenvInt("SERVER_QUOTA_TEXT_BYTES", 1<<20)  // 1MB
+	maxStorage := utils.GetenvInt("SERVER_QUOTA_STORAGE", 1<<30)       // 1GB
+	quotaPlans := utils.GetenvString("SERVER_QUOTA_PLANS", "")         // e.g. "pro:pages=100000,storage=10737418240"
+	admins := utils.GetenvString("SERVER_ADMINS", "")                  // Comma separated account IDs
+	blocklist := utils.GetenvString("SERVER_MODERATION_BLOCKLIST", "") // File of patterns, one per line
+	maxLinks := utils.GetenvInt("SERVER_MODERATION_MAX_LINKS", 20)
+	spamThreshold := utils.GetenvInt("SERVER_MODERATION_SPAM_THRESHOLD", 90) // Percent
+	webhookAttempts := utils.GetenvInt("SERVER_WEBHOOK_ATTEMPTS", 8)
+	webhookBackoff := utils.GetenvInt("SERVER_WEBHOOK_BACKOFF", 30)           // Seconds before the first retry
+	webhookPrivate := utils.GetenvBool("SERVER_WEBHOOK_ALLOW_PRIVATE", false) // Allows local receivers, for testing
+	viewSalt := utils.GetenvString("SERVER_VIEW_SALT", utils.RandSha1())      // Keeps repeat views recognized across restarts
+	gateways := utils.GetenvString("SERVER_GATEWAY_ADDRS", "127.0.0.1,::1")   // Comma separated addresses the gateway connects from
 
-	s := server{}
+	s := server{
+		maxAttachmentSize: int64(maxAttachmentSize),
+		viewSalt:          viewSalt,
+		limits: quota.Limits{
+			Pages:     int64(maxPages),
+			TextBytes: int64(maxTextBytes),
+			Storage:   int64(maxStorage),
+		},
+		admins:          make(map[string]bool),
+		gateways:        make(map[string]bool),
+		webhookClient:   webhook.Client(webhookTimeout, webhookPrivate),
+		webhookPrivate:  webhookPrivate,
+		webhookAttempts: int64(webhookAttempts),
+		webhookBackoff:  time.Duration(webhookBackoff) * time.Second,
+	}
+	plans, err := quota.ParsePlans(quotaPlans, s.limits)
+	if err != nil {
+		panic(err)
+	}
+	s.plans = plans
+	for _, id := range strings.Split(admins, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			s.admins[id] = true
+		}
+	}
+	for _, addr := range strings.Split(gateways, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			s.gateways[canonicalIP(addr)] = true
+		}
+	}
+
+	// Moderation
+	s.moderator = &moderation.Pipeline{}
+	if blocklist != "" {
+		f, err := os.Open(blocklist)
+		if err != nil {
+			panic(err)
+		}
+		patterns, err := moderation.ParseBlocklist(f)
+		f.Close()
+		if err != nil {
+			panic(err)
+		}
+		s.moderator.Register(patterns)
+	}
+	s.moderator.Register(moderation.LinkLimit{Max: maxLinks})
+	s.classifier = moderation.NewClassifier(float64(spamThreshold) / 100)
+	s.moderator.Register(s.classifier)
 
 	// Initialize State
 	state.Register("memory", memory.New)
 	state.Register("sqlite", sqlite.New)
-	s.state = state.New(stateBackend)
+	s.broker = broker.New()
+	s.state = broker.State(state.New(stateBackend), s.broker)
+	blobs, err := disk.New(blobRoot)
+	if err != nil {
+		panic(err)
+	}
+	s.blobs = blobs
+	go s.publishScheduled()
+	go s.queueWebhooks()
+	go s.deliverWebhooks()
+
+	// The spam classifier learns from every decision admins have reviewed.
+	decisions, err := s.state.ModerationDecisions("", false)
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range decisions {
+		if d.Verdict != pages.ReviewVerdict_PENDING {
+			s.classifier.Train(d.Text, d.Verdict == pages.ReviewVerdict_SPAM)
+		}
+	}
 
 	// Credentials
 	creds, err := credentials.NewServerTLSFromFile("dev.crt", "dev.key")
@@ -234,6 +2700,10 @@ func main() {
 	)
 	pages.RegisterAccountsServer(gs, &s)
 	pages.RegisterPagesServer(gs, &s)
+	pages.RegisterCommentsServer(gs, &s)
+	pages.RegisterModerationServer(gs, &s)
+	pages.RegisterWebhooksServer(gs, &s)
+	pages.RegisterNotebooksServer(gs, &s)
 
 	// Listen over TCP
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -251,7 +2721,7 @@ func main() {
 	}
 
 	// Serve gRPC gateway
-	if err := proxy.Serve(fmt.Sprintf("%s:%d", host, port), proxyPort); err != nil {
+	if err := proxy.Serve(fmt.Sprintf("%s:%d", host, port), proxyPort, proxy.FeedLimits{Default: feedLimit, Max: maxFeedLimit}); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,214 @@
+package proxy
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nathanborror/pages/pages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+)
+
+// attachmentChunkSize is the size of the chunks request bodies are streamed
+// to the server in.
+const attachmentChunkSize = 64 * 1024
+
+var (
+	errMissingFile = grpc.Errorf(codes.InvalidArgument, "Missing file")
+)
+
+// attachmentUploadHandler streams a request body to AttachmentUpload. Bodies
+// may be raw file content, named by the 'name' query parameter, or a
+// multipart form with a 'file' field. The target page is given by the
+// 'page_id' query parameter.
+func attachmentUploadHandler(ctx context.Context, mux *runtime.ServeMux, client pages.PagesClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		if r.Method != "POST" {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		rctx, cancel, err := attachmentContext(ctx, r)
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		defer cancel()
+
+		first := &pages.AttachmentChunk{
+			PageId:      r.URL.Query().Get("page_id"),
+			Name:        r.URL.Query().Get("name"),
+			ContentType: r.Header.Get("Content-Type"),
+			Size:        r.ContentLength,
+		}
+		var body io.Reader = r.Body
+		if strings.HasPrefix(first.ContentType, "multipart/form-data") {
+			part, err := formFile(r)
+			if err != nil {
+				runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+				return
+			}
+			defer part.Close()
+			first.Name = part.FileName()
+			first.ContentType = part.Header.Get("Content-Type")
+			first.Size = 0
+			body = part
+		}
+		if first.Size < 0 {
+			first.Size = 0
+		}
+
+		stream, err := client.AttachmentUpload(rctx)
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		chunk := first
+		buf := make([]byte, attachmentChunkSize)
+		for {
+			n, err := io.ReadFull(body, buf)
+			if n > 0 || chunk == first {
+				chunk.Data = buf[:n]
+				if err := stream.Send(chunk); err != nil {
+					// The server ended the stream; its error is reported by CloseAndRecv.
+					break
+				}
+				chunk = &pages.AttachmentChunk{}
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			if err != nil {
+				runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+				return
+			}
+		}
+		attachment, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		buf, err = outboundMarshaler.Marshal(attachment)
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", outboundMarshaler.ContentType())
+		w.Write(buf)
+	}
+}
+
+// inlineTypes are the content types shown inline. Uploaders choose an
+// attachment's content type, so types that can run script, like SVG images,
+// are always sent as downloads.
+var inlineTypes = map[string]bool{
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// attachmentDownloadHandler writes the attachment named by the 'id' query
+// parameter to the response. Raster images and PDFs are shown inline,
+// everything else is sent as a download. Either way the response is
+// sandboxed so it can't run script on the gateway's origin.
+func attachmentDownloadHandler(ctx context.Context, mux *runtime.ServeMux, client pages.PagesClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		if r.Method != "GET" && r.Method != "HEAD" {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		rctx, cancel, err := attachmentContext(ctx, r)
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		defer cancel()
+
+		stream, err := client.AttachmentDownload(rctx, &pages.AttachmentDownloadRequest{Id: r.URL.Query().Get("id")})
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		chunk, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		disposition := "attachment"
+		if mediaType, _, err := mime.ParseMediaType(chunk.ContentType); err == nil && inlineTypes[mediaType] {
+			disposition = "inline"
+		}
+		w.Header().Set("Content-Type", chunk.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(chunk.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": chunk.Name}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		if r.Method == "HEAD" {
+			return
+		}
+		for {
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// Headers are already sent so the response can only be cut short.
+				grpclog.Printf("Failed to download attachment: %v", err)
+				return
+			}
+		}
+	}
+}
+
+// attachmentContext returns a cancelable context carrying the request's
+// metadata. Browsers can't set headers on plain links so the token may also
+// be given as a 'token' query parameter.
+func attachmentContext(ctx context.Context, r *http.Request) (context.Context, context.CancelFunc, error) {
+	if token := r.URL.Query().Get("token"); token != "" && r.Header.Get("Grpc-Metadata-Token") == "" {
+		r.Header.Set("Grpc-Metadata-Token", token)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	rctx, err := runtime.AnnotateContext(ctx, r)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return rctx, cancel, nil
+}
+
+// formFile returns the 'file' part of a multipart request.
+func formFile(r *http.Request) (*multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, errMissingFile
+		}
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if part.FormName() == "file" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
@@ -0,0 +1,279 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/nathanborror/pages/pages"
+	"github.com/nathanborror/pages/render"
+)
+
+// FeedLimits bounds the number of items in a feed. Default is used when a
+// request has no 'limit' query parameter and Max caps the parameter.
+type FeedLimits struct {
+	Default int
+	Max     int
+}
+
+// feed is the format independent content of a feed.
+type feed struct {
+	title   string
+	link    string
+	updated time.Time
+	items   []feedItem
+}
+
+type feedItem struct {
+	id        string
+	title     string
+	link      string
+	author    string
+	content   string
+	published time.Time
+	updated   time.Time
+}
+
+// feedHandler serves a feed of public pages, newest first, written by write.
+// The 'account_id' query parameter limits the feed to one author's pages and
+// 'limit' sets the number of items. Responses carry an ETag and a
+// Last-Modified date so feed readers can make conditional requests.
+func feedHandler(ctx context.Context, mux *runtime.ServeMux, client pages.PagesClient, limits FeedLimits, contentType string, write func(*feed) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+		if r.Method != "GET" && r.Method != "HEAD" {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		limit := limits.Default
+		if v := r.URL.Query().Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				http.Error(w, "Invalid limit", http.StatusBadRequest)
+				return
+			}
+			limit = n
+		}
+		if limit > limits.Max {
+			limit = limits.Max
+		}
+
+		// Feeds are requested anonymously so they only ever include public
+		// pages, whatever credentials the reader sends.
+		set, err := client.PageList(ctx, &pages.PageListRequest{})
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		f := buildFeed(r, set.Pages, r.URL.Query().Get("account_id"), limit)
+		body, err := write(f)
+		if err != nil {
+			runtime.HTTPError(ctx, outboundMarshaler, w, r, err)
+			return
+		}
+		sum := sha1.Sum(body)
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		http.ServeContent(w, r, "", f.updated, bytes.NewReader(body))
+	}
+}
+
+// buildFeed returns the newest limit public pages, written by account if
+// it is set.
+func buildFeed(r *http.Request, recs []*pages.Page, account string, limit int) *feed {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	base := fmt.Sprintf("%s://%s", scheme, r.Host)
+	pageURL := func(id string) string {
+		return base + "/page.get?id=" + url.QueryEscape(id)
+	}
+
+	var public []*pages.Page
+	for _, rec := range recs {
+		if rec.Visibility != pages.Visibility_PUBLIC || rec.Status != pages.PageStatus_PUBLISHED {
+			continue
+		}
+		if account != "" && rec.Account.Id != account {
+			continue
+		}
+		public = append(public, rec)
+	}
+	sort.Sort(byPublished(public))
+	if len(public) > limit {
+		public = public[:limit]
+	}
+
+	f := &feed{title: "Pages", link: base + r.URL.RequestURI()}
+	if account != "" && len(public) > 0 {
+		f.title = "Pages by " + public[0].Account.Name
+	}
+	for _, rec := range public {
+		item := feedItem{
+			id:        "urn:page:" + rec.Id,
+			title:     rec.Title,
+			link:      pageURL(rec.Id),
+			author:    rec.Account.Name,
+			published: time.Unix(0, published(rec)).UTC(),
+			updated:   time.Unix(0, rec.Modified).UTC(),
+			content: render.HTML(rec.Text, func(ref string) string {
+				for _, other := range public {
+					if other.Id == ref || strings.EqualFold(other.Title, ref) {
+						return pageURL(other.Id)
+					}
+				}
+				return ""
+			}),
+		}
+		if item.title == "" {
+			item.title = "Untitled"
+		}
+		if item.updated.After(f.updated) {
+			f.updated = item.updated
+		}
+		f.items = append(f.items, item)
+	}
+	return f
+}
+
+// published returns when a page was published. Pages stored before
+// publishing was tracked fall back to their creation time.
+func published(rec *pages.Page) int64 {
+	if rec.PublishAt != 0 {
+		return rec.PublishAt
+	}
+	return rec.Created
+}
+
+type byPublished []*pages.Page
+
+func (p byPublished) Len() int           { return len(p) }
+func (p byPublished) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byPublished) Less(i, j int) bool { return published(p[i]) > published(p[j]) }
+
+// RSS 2.0
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	Value     string `xml:",chardata"`
+	PermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+func writeRSS(f *feed) ([]byte, error) {
+	doc := rss{Version: "2.0", Channel: rssChannel{
+		Title:       f.title,
+		Link:        f.link,
+		Description: f.title,
+	}}
+	if !f.updated.IsZero() {
+		doc.Channel.LastBuildDate = f.updated.Format(time.RFC1123Z)
+	}
+	for _, item := range f.items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.title,
+			Link:        item.link,
+			GUID:        rssGUID{Value: item.id},
+			PubDate:     item.published.Format(time.RFC1123Z),
+			Description: item.content,
+		})
+	}
+	return marshalXML(doc)
+}
+
+// Atom
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Author    atomAuthor  `xml:"author"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func writeAtom(f *feed) ([]byte, error) {
+	doc := atomFeed{
+		Title:   f.title,
+		ID:      f.link,
+		Updated: f.updated.Format(time.RFC3339),
+		Link:    atomLink{Href: f.link, Rel: "self"},
+	}
+	for _, item := range f.items {
+		doc.Entries = append(doc.Entries, atomEntry{
+			Title:     item.title,
+			ID:        item.id,
+			Link:      atomLink{Href: item.link},
+			Published: item.published.Format(time.RFC3339),
+			Updated:   item.updated.Format(time.RFC3339),
+			Author:    atomAuthor{Name: item.author},
+			Content:   atomContent{Type: "html", Value: item.content},
+		})
+	}
+	return marshalXML(doc)
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(body, '\n')...), nil
+}
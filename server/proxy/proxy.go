@@ -1,8 +1,13 @@
 package proxy
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"unicode"
 
 	"golang.org/x/net/context"
 
@@ -29,12 +34,140 @@ func allowCORS(h http.Handler) http.Handler {
 
 func preflightHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Accept, Grpc-Metadata-token")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, DELETE")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE")
 	return
 }
 
+// patchMask sets the update mask of PATCH requests without one to the fields
+// present in the JSON body, other than the ID, so a PATCH only changes the
+// fields it sends.
+func patchMask(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+		// Bodies that aren't JSON objects are left for the gateway to reject.
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(data, &body); err != nil {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if _, ok := body["update_mask"]; ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if _, ok := body["updateMask"]; ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+		paths := []string{}
+		for name := range body {
+			if name != "id" {
+				paths = append(paths, snakeCase(name))
+			}
+		}
+		if len(paths) == 0 {
+			http.Error(w, "Nothing to update", http.StatusBadRequest)
+			return
+		}
+		sort.Strings(paths)
+		mask, err := json.Marshal(map[string][]string{"paths": paths})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		body["update_mask"] = mask
+		if data, err = json.Marshal(body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(data))
+		r.ContentLength = int64(len(data))
+		h.ServeHTTP(w, r)
+	})
+}
+
+// conditional supports conditional reads. It forwards the If-None-Match and
+// If-Modified-Since headers to the server as metadata and returns the
+// server's validators as ETag and Last-Modified headers. Reads the server
+// reports as not modified are answered with 304 Not Modified.
+func conditional(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" || r.Method == "HEAD" {
+			for _, name := range []string{"If-None-Match", "If-Modified-Since"} {
+				if v := r.Header.Get(name); v != "" {
+					r.Header.Set("Grpc-Metadata-"+name, v)
+				}
+			}
+		}
+		h.ServeHTTP(&conditionalWriter{ResponseWriter: w}, r)
+	})
+}
+
+// conditionalWriter rewrites the gateway's response to a conditional read.
+type conditionalWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *conditionalWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	header := w.Header()
+	for _, name := range []string{"ETag", "Last-Modified"} {
+		if v := header.Get("Grpc-Metadata-" + name); v != "" {
+			header.Set(name, v)
+			header.Del("Grpc-Metadata-" + name)
+		}
+	}
+	if header.Get("ETag") != "" {
+		// Responses depend on who is reading, so only the reader may cache
+		// them and they must be revalidated before reuse.
+		header.Set("Cache-Control", "private, no-cache")
+		if code == http.StatusPreconditionFailed {
+			w.notModified = true
+			header.Del("Content-Type")
+			code = http.StatusNotModified
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// snakeCase converts a lowerCamelCase JSON field name to its proto name.
+// Proto names are returned unchanged.
+func snakeCase(name string) string {
+	var buf bytes.Buffer
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			buf.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
 // Serve starts the gateway
-func Serve(endpoint string, port int) error {
+func Serve(endpoint string, port int, feedLimits FeedLimits) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -54,6 +187,36 @@ func Serve(endpoint string, port int) error {
 	if err := pages.RegisterPagesHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 		return err
 	}
+	if err := pages.RegisterCommentsHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	if err := pages.RegisterModerationHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	if err := pages.RegisterWebhooksHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	if err := pages.RegisterNotebooksHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+
+	// Attachments are streamed and feeds are XML, so they're served outside
+	// the gateway.
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := pages.NewPagesClient(conn)
+
+	routes := http.NewServeMux()
+	routes.Handle("/", patchMask(mux))
+	routes.Handle("/page.get", conditional(mux))
+	routes.Handle("/pages", conditional(mux))
+	routes.Handle("/attachment.upload", attachmentUploadHandler(ctx, mux, client))
+	routes.Handle("/attachment.download", attachmentDownloadHandler(ctx, mux, client))
+	routes.Handle("/feed.rss", feedHandler(ctx, mux, client, feedLimits, "application/rss+xml; charset=utf-8", writeRSS))
+	routes.Handle("/feed.atom", feedHandler(ctx, mux, client, feedLimits, "application/atom+xml; charset=utf-8", writeAtom))
 
-	return http.ListenAndServe(fmt.Sprintf(":%d", port), allowCORS(mux))
+	return http.ListenAndServe(fmt.Sprintf(":%d", port), allowCORS(routes))
 }
@@ -0,0 +1,280 @@
+package broker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nathanborror/pages/pages"
+	"github.com/nathanborror/pages/state"
+)
+
+// ErrSubscriberBehind means a subscriber didn't keep up with published events
+// and was disconnected.
+var ErrSubscriberBehind = errors.New("Subscriber fell behind and was disconnected")
+
+// Broker is an in-process publish/subscribe hub for page events.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// Event is a published page event. Deleted events also carry the roles the
+// page's collaborators had, keyed by account ID, since they can't be looked
+// up once the page is gone.
+type Event struct {
+	*pages.PageEvent
+	Roles map[string]pages.Role
+}
+
+// Subscription receives events matching its filter until it is closed.
+type Subscription struct {
+	C <-chan *Event
+
+	c      chan *Event
+	filter func(*Event) bool
+	err    error
+}
+
+// Err returns the reason the subscription was closed by the broker, if any.
+func (sub *Subscription) Err() error {
+	return sub.err
+}
+
+// New returns an empty broker.
+func New() *Broker {
+	return &Broker{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscribe registers a subscription for events matching the filter. A nil
+// filter matches every event. Buffer is the number of events that may be
+// queued before the subscriber is considered stalled.
+func (b *Broker) Subscribe(buffer int, filter func(*Event) bool) *Subscription {
+	c := make(chan *Event, buffer)
+	sub := &Subscription{C: c, c: c, filter: filter}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+// Unsubscribe removes a subscription and closes its channel.
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub, nil)
+}
+
+// Publish delivers an event to every matching subscriber. Publish never
+// blocks: subscribers whose buffers are full are disconnected so a stalled
+// client can't hold up writers.
+func (b *Broker) Publish(e *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if sub.filter != nil && !sub.filter(e) {
+			continue
+		}
+		select {
+		case sub.c <- e:
+		default:
+			b.remove(sub, ErrSubscriberBehind)
+		}
+	}
+}
+
+func (b *Broker) remove(sub *Subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	sub.err = err
+	close(sub.c)
+}
+
+// State wraps a state backend so every page mutation is published to the
+// broker.
+func State(s state.State, b *Broker) state.State {
+	return &publisher{State: s, broker: b}
+}
+
+type publisher struct {
+	state.State
+	broker *Broker
+}
+
+func (s *publisher) publish(kind pages.PageEventType, page *pages.Page) {
+	s.publishRoles(kind, page, nil)
+}
+
+func (s *publisher) publishRoles(kind pages.PageEventType, page *pages.Page, roles map[string]pages.Role) {
+	s.broker.Publish(&Event{
+		PageEvent: &pages.PageEvent{
+			Type:    kind,
+			Page:    proto.Clone(page).(*pages.Page),
+			Created: time.Now().UTC().UnixNano(),
+		},
+		Roles: roles,
+	})
+}
+
+// roles returns the roles of a page's collaborators, keyed by account ID.
+func (s *publisher) roles(id string) map[string]pages.Role {
+	collaborators, err := s.State.PageCollaborators(id)
+	if err != nil {
+		return nil
+	}
+	out := make(map[string]pages.Role)
+	for _, c := range collaborators {
+		out[c.Account.Id] = c.Role
+	}
+	return out
+}
+
+// PageCreate creates a page and publishes a created event.
+func (s *publisher) PageCreate(account, text string, visibility pages.Visibility, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	page, err := s.State.PageCreate(account, text, visibility, status, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(pages.PageEventType_CREATED, page)
+	return page, nil
+}
+
+// PageFork forks a page and publishes a created event.
+func (s *publisher) PageFork(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	page, err := s.State.PageFork(id, account, status, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(pages.PageEventType_CREATED, page)
+	return page, nil
+}
+
+// PageUpdate updates a page and publishes an updated event.
+func (s *publisher) PageUpdate(id, account, text string, visibility pages.Visibility, fields []string) (*pages.Page, error) {
+	page, err := s.State.PageUpdate(id, account, text, visibility, fields)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(pages.PageEventType_UPDATED, page)
+	return page, nil
+}
+
+// PagePatch patches a page and publishes an updated event.
+func (s *publisher) PagePatch(id, account string, version int64, text string) (*pages.Page, error) {
+	page, err := s.State.PagePatch(id, account, version, text)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(pages.PageEventType_UPDATED, page)
+	return page, nil
+}
+
+// PageStatusUpdate changes a page's publishing state and publishes an
+// updated event.
+func (s *publisher) PageStatusUpdate(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	page, err := s.State.PageStatusUpdate(id, account, status, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(pages.PageEventType_UPDATED, page)
+	return page, nil
+}
+
+// PagePublishDue publishes scheduled pages and publishes an updated event for
+// each.
+func (s *publisher) PagePublishDue(ts int64) ([]*pages.Page, error) {
+	out, err := s.State.PagePublishDue(ts)
+	if err != nil {
+		return nil, err
+	}
+	for _, page := range out {
+		s.publish(pages.PageEventType_UPDATED, page)
+	}
+	return out, nil
+}
+
+// PageDelete deletes a page and publishes a deleted event.
+func (s *publisher) PageDelete(id, account string) error {
+	page, err := s.State.Page(id)
+	if err != nil {
+		return err
+	}
+	roles := s.roles(id)
+	if err := s.State.PageDelete(id, account); err != nil {
+		return err
+	}
+	s.publishRoles(pages.PageEventType_DELETED, page, roles)
+	return nil
+}
+
+// PageRestore restores a page and publishes a created or updated event.
+func (s *publisher) PageRestore(account string, page *pages.Page) (*pages.Page, error) {
+	kind := pages.PageEventType_UPDATED
+	if _, err := s.State.Page(page.Id); err == state.ErrPageNotFound {
+		kind = pages.PageEventType_CREATED
+	}
+	rec, err := s.State.PageRestore(account, page)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(kind, rec)
+	return rec, nil
+}
+
+// PageBatchCreate creates pages and publishes a created event for each.
+func (s *publisher) PageBatchCreate(account string, items []*pages.PageCreateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	out, errs, err := s.State.PageBatchCreate(account, items, atomic)
+	s.publishBatch(pages.PageEventType_CREATED, out, errs, err)
+	return out, errs, err
+}
+
+// PageBatchUpdate updates pages and publishes an updated event for each.
+func (s *publisher) PageBatchUpdate(account string, items []*pages.PageUpdateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	out, errs, err := s.State.PageBatchUpdate(account, items, atomic)
+	s.publishBatch(pages.PageEventType_UPDATED, out, errs, err)
+	return out, errs, err
+}
+
+// PageBatchDelete deletes pages and publishes a deleted event for each.
+func (s *publisher) PageBatchDelete(account string, ids []string, atomic bool) ([]*pages.Page, []error, error) {
+	roles := make([]map[string]pages.Role, len(ids))
+	for i, id := range ids {
+		roles[i] = s.roles(id)
+	}
+	out, errs, err := s.State.PageBatchDelete(account, ids, atomic)
+	if err != nil {
+		return out, errs, err
+	}
+	for i, page := range out {
+		if errs[i] == nil && page != nil {
+			s.publishRoles(pages.PageEventType_DELETED, page, roles[i])
+		}
+	}
+	return out, errs, err
+}
+
+func (s *publisher) publishBatch(kind pages.PageEventType, out []*pages.Page, errs []error, err error) {
+	if err != nil {
+		return
+	}
+	for i, page := range out {
+		if errs[i] == nil && page != nil {
+			s.publish(kind, page)
+		}
+	}
+}
+
+// AttachmentCreate attaches a file to a page and publishes an updated event.
+func (s *publisher) AttachmentCreate(page, account, name, contentType, hash string, size int64) (*pages.Attachment, error) {
+	attachment, err := s.State.AttachmentCreate(page, account, name, contentType, hash, size)
+	if err != nil {
+		return nil, err
+	}
+	if rec, err := s.State.Page(page); err == nil {
+		s.publish(pages.PageEventType_UPDATED, rec)
+	}
+	return attachment, nil
+}
@@ -0,0 +1,71 @@
+package disk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/nathanborror/pages/state"
+)
+
+type disk struct {
+	root string
+}
+
+// New returns a blob store that keeps blobs as files beneath root.
+func New(root string) (state.BlobStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &disk{root: root}, nil
+}
+
+// Put stores the content read from r and returns its SHA-256 hash and size.
+// Content is written to a temporary file first and only moved into place
+// once it has been read completely.
+func (s *disk) Put(r io.Reader) (string, int64, error) {
+	tmp, err := ioutil.TempFile(s.root, "upload-")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	path := s.path(hash)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, err
+	}
+	return hash, size, nil
+}
+
+// Get returns a reader for the blob with the given hash.
+func (s *disk) Get(hash string) (io.ReadCloser, error) {
+	if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha256.Size*2 {
+		return nil, state.ErrBlobNotFound
+	}
+	f, err := os.Open(s.path(hash))
+	if os.IsNotExist(err) {
+		return nil, state.ErrBlobNotFound
+	}
+	return f, err
+}
+
+// path shards blobs into directories by the first two characters of their
+// hash to keep directory sizes manageable.
+func (s *disk) path(hash string) string {
+	return filepath.Join(s.root, hash[:2], hash[2:])
+}
@@ -1,27 +1,86 @@
 package memory
 
 import (
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/nathanborror/pages/pages"
 	"github.com/nathanborror/pages/state"
+	"github.com/nathanborror/pages/template"
 	"github.com/nathanborror/pages/utils"
+	"github.com/nathanborror/pages/wiki"
 )
 
+// memory keeps records in maps guarded by mu. Records are never handed out:
+// readers return copies, and pages are changed by storing an edited copy, so
+// callers can use what they're given without holding the lock.
 type memory struct {
-	accounts  map[string]*pages.Account
-	tokens    map[string]string
-	passwords map[string]string
-	pages     map[string]*pages.Page
+	mu sync.RWMutex
+
+	accounts      map[string]*pages.Account
+	tokens        map[string]string
+	passwords     map[string]string
+	pages         map[string]*pages.Page
+	revisions     map[string][]revision
+	tombstones    map[string]*tombstone
+	links         map[string][]string
+	collaborators map[string]map[string]*pages.Collaborator
+	attachments   map[string]*pages.Attachment
+	comments      map[string]*pages.Comment
+	templates     map[string]*pages.Template
+	decisions     map[string]*pages.ModerationDecision
+	webhooks      map[string]*pages.Webhook
+	deliveries    map[string]*pages.WebhookDelivery
+	views         map[string]map[int64]int64
+	viewers       map[string]map[string]int64
+	changes       map[string]*pages.PageChange
+	events        []*pages.PageChange
+	seq           int64
+	webhookCursor int64
+	notebooks     map[string]*pages.Notebook
+	notebookPages map[string][]string
+	filed         map[string]string
+}
+
+// revision is the text of a page version and when it was saved.
+type revision struct {
+	text    string
+	created int64
+}
+
+// tombstone keeps a deleted page and its revisions.
+type tombstone struct {
+	page      *pages.Page
+	revisions []revision
+	deleted   int64
 }
 
 // New returns a memory backed state interface.
 func New() state.State {
 	return &memory{
-		make(map[string]*pages.Account),
-		make(map[string]string),
-		make(map[string]string),
-		make(map[string]*pages.Page),
+		accounts:      make(map[string]*pages.Account),
+		tokens:        make(map[string]string),
+		passwords:     make(map[string]string),
+		pages:         make(map[string]*pages.Page),
+		revisions:     make(map[string][]revision),
+		tombstones:    make(map[string]*tombstone),
+		links:         make(map[string][]string),
+		collaborators: make(map[string]map[string]*pages.Collaborator),
+		attachments:   make(map[string]*pages.Attachment),
+		comments:      make(map[string]*pages.Comment),
+		templates:     make(map[string]*pages.Template),
+		decisions:     make(map[string]*pages.ModerationDecision),
+		webhooks:      make(map[string]*pages.Webhook),
+		deliveries:    make(map[string]*pages.WebhookDelivery),
+		views:         make(map[string]map[int64]int64),
+		viewers:       make(map[string]map[string]int64),
+		changes:       make(map[string]*pages.PageChange),
+		notebooks:     make(map[string]*pages.Notebook),
+		notebookPages: make(map[string][]string),
+		filed:         make(map[string]string),
 	}
 }
 
@@ -32,18 +91,26 @@ func (s *memory) Description() string {
 
 // Account returns an account for a given id.
 func (s *memory) Account(id string) (*pages.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.account(id)
+}
+
+func (s *memory) account(id string) (*pages.Account, error) {
 	rec, ok := s.accounts[id]
 	if !ok {
 		return nil, state.ErrAccountNotFound
 	}
-	return rec, nil
+	return proto.Clone(rec).(*pages.Account), nil
 }
 
 // AccountForEmail returns an account for a given email address.
 func (s *memory) AccountForEmail(email string) (*pages.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	for _, rec := range s.accounts {
 		if rec.Email == email {
-			return s.Account(rec.Id)
+			return s.account(rec.Id)
 		}
 	}
 	return nil, state.ErrAccountNotFound
@@ -51,16 +118,20 @@ func (s *memory) AccountForEmail(email string) (*pages.Account, error) {
 
 // AccountForToken returns an account for a given token.
 func (s *memory) AccountForToken(token string) (*pages.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	if id, ok := s.tokens[token]; ok {
-		return s.Account(id)
+		return s.account(id)
 	}
 	return nil, state.ErrAccountNotFound
 }
 
 func (s *memory) AccountForPassword(id, attempt string) (*pages.Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	if password, ok := s.passwords[id]; ok {
 		if utils.IsPasswordValid(password, attempt) {
-			return s.Account(id)
+			return s.account(id)
 		}
 	}
 	return nil, state.ErrAccountNotFound
@@ -68,6 +139,8 @@ func (s *memory) AccountForPassword(id, attempt string) (*pages.Account, error)
 
 // AccountCreate creates and returns a new account.
 func (s *memory) AccountCreate(name, email, password string) (*pages.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	ts := now()
 	rec := pages.Account{
 		Name:     name,
@@ -78,11 +151,13 @@ func (s *memory) AccountCreate(name, email, password string) (*pages.Account, er
 	}
 	s.accounts[rec.Id] = &rec
 	s.passwords[rec.Id] = utils.PasswordMake(password)
-	return &rec, nil
+	return s.account(rec.Id)
 }
 
 // AccountTokenSet sets an account's access token.
 func (s *memory) AccountTokenSet(id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	token := utils.RandSha1()
 	rec, ok := s.accounts[id]
 	if !ok {
@@ -92,68 +167,1737 @@ func (s *memory) AccountTokenSet(id string) (string, error) {
 	return token, nil
 }
 
+// AccountPlanSet moves an account to a quota plan.
+func (s *memory) AccountPlanSet(id, plan string) (*pages.Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.accounts[id]
+	if !ok {
+		return nil, state.ErrAccountNotFound
+	}
+	rec.Plan = plan
+	rec.Modified = now()
+	return s.account(id)
+}
+
+// AccountUsage counts the pages an account owns and the bytes attached to
+// them.
+func (s *memory) AccountUsage(id string) (int64, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var count, storage int64
+	for _, rec := range s.pages {
+		if rec.Account.Id == id {
+			count++
+		}
+	}
+	for _, rec := range s.attachments {
+		if page, ok := s.pages[rec.PageId]; ok && page.Account.Id == id {
+			storage += rec.Size
+		}
+	}
+	return count, storage, nil
+}
+
 // Pages returns all pages.
 func (s *memory) Pages() ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.Page{}
+	for _, rec := range s.pages {
+		out = append(out, clonePage(rec))
+	}
+	return out, nil
+}
+
+// PagesVisible returns all public pages along with every page belonging to
+// or shared with the viewer.
+func (s *memory) PagesVisible(viewer string) ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.Page{}
+	for _, rec := range s.pages {
+		if (rec.Visibility == pages.Visibility_PUBLIC && rec.Status == pages.PageStatus_PUBLISHED) || s.role(rec, viewer) != pages.Role_NONE {
+			out = append(out, clonePage(rec))
+		}
+	}
+	return out, nil
+}
+
+// PagesForAccount returns all pages authored by the account.
+func (s *memory) PagesForAccount(account string) ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	out := []*pages.Page{}
 	for _, rec := range s.pages {
-		out = append(out, rec)
+		if rec.Account.Id == account {
+			out = append(out, clonePage(rec))
+		}
 	}
 	return out, nil
 }
 
 // Page returns an page for a given id.
 func (s *memory) Page(id string) (*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.page(id)
+}
+
+func (s *memory) page(id string) (*pages.Page, error) {
 	rec, ok := s.pages[id]
 	if !ok {
 		return nil, state.ErrPageNotFound
 	}
-	return rec, nil
+	return clonePage(rec), nil
+}
+
+// PageVisible returns a page for a given id if the viewer is allowed to read
+// it. Private pages not shared with the viewer, and other authors' drafts and
+// scheduled pages, are reported as not found.
+func (s *memory) PageVisible(id, viewer string) (*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	hidden := rec.Visibility == pages.Visibility_PRIVATE || rec.Status != pages.PageStatus_PUBLISHED
+	if hidden && s.role(rec, viewer) == pages.Role_NONE {
+		return nil, state.ErrPageNotFound
+	}
+	return clonePage(rec), nil
 }
 
 // PageCreate creates and returns a new page.
-func (s *memory) PageCreate(accountID, text string) (*pages.Page, error) {
+func (s *memory) PageCreate(accountID, text string, visibility pages.Visibility, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pageCreate(accountID, text, visibility, status, publishAt), nil
+}
+
+func (s *memory) pageCreate(accountID, text string, visibility pages.Visibility, status pages.PageStatus, publishAt int64) *pages.Page {
 	ts := now()
 	account := s.accounts[accountID]
 	page := pages.Page{
-		Account:  account,
-		Text:     text,
-		Created:  ts,
-		Modified: ts,
-		Id:       uniqueID(),
+		Account:    account,
+		Text:       text,
+		Created:    ts,
+		Modified:   ts,
+		Id:         uniqueID(),
+		Visibility: visibility,
+		Version:    1,
+		Status:     status,
+		PublishAt:  state.PublishTime(status, publishAt, ts),
 	}
 	s.pages[page.Id] = &page
-	return s.Page(page.Id)
+	s.revisions[page.Id] = []revision{{text, ts}}
+	s.index(&page)
+	s.event(page.Id, pages.PageEventType_CREATED, ts)
+	return clonePage(&page)
+}
+
+// PageUpdate updates the given fields and returns the updated page.
+func (s *memory) PageUpdate(id, account, text string, visibility pages.Visibility, fields []string) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.canUpdate(id, account, visibility, fields); err != nil {
+		return nil, err
+	}
+	return s.pageUpdate(id, text, visibility, fields), nil
+}
+
+func (s *memory) pageUpdate(id, text string, visibility pages.Visibility, fields []string) *pages.Page {
+	rec := clonePage(s.pages[id])
+	ts := now()
+	if state.HasField(fields, state.FieldText) {
+		if rec.Text != text {
+			rec.Version++
+			s.revisions[rec.Id] = append(s.revisions[rec.Id], revision{text, ts})
+		}
+		rec.Text = text
+		s.index(rec)
+	}
+	if state.HasField(fields, state.FieldVisibility) {
+		rec.Visibility = visibility
+	}
+	rec.Modified = ts
+	s.pages[id] = rec
+	s.event(id, pages.PageEventType_UPDATED, rec.Modified)
+	return clonePage(rec)
 }
 
-// PageUpdate updates and returns the updated page.
-func (s *memory) PageUpdate(id, account, text string) (*pages.Page, error) {
+// PagePatch replaces a page's text if the page is still at the given version.
+func (s *memory) PagePatch(id, account string, version int64, text string) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	rec, ok := s.pages[id]
 	if !ok {
 		return nil, state.ErrPageNotFound
 	}
-	if rec.Account.Id != account {
+	if s.role(rec, account) < pages.Role_EDITOR {
 		return nil, state.ErrPageUnauthorized
 	}
+	if rec.Version != version {
+		return nil, state.ErrPageStale
+	}
+	rec = clonePage(rec)
 	rec.Text = text
+	rec.Version++
 	rec.Modified = now()
-	s.pages[rec.Id] = rec
-	return s.Page(rec.Id)
+	s.revisions[rec.Id] = append(s.revisions[rec.Id], revision{text, rec.Modified})
+	s.index(rec)
+	s.pages[id] = rec
+	s.event(id, pages.PageEventType_UPDATED, rec.Modified)
+	return clonePage(rec), nil
+}
+
+// PageRevision returns the text of a page as of the given version.
+func (s *memory) PageRevision(id string, version int64) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	revisions, ok := s.revisions[id]
+	if !ok {
+		return "", state.ErrPageNotFound
+	}
+	if version < 1 || version > int64(len(revisions)) {
+		return "", state.ErrRevisionNotFound
+	}
+	return revisions[version-1].text, nil
+}
+
+// PageStatusUpdate changes a page's publishing state. Only owners may
+// publish or unpublish.
+func (s *memory) PageStatusUpdate(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	if s.role(rec, account) != pages.Role_OWNER {
+		return nil, state.ErrPageUnauthorized
+	}
+	ts := now()
+	rec = clonePage(rec)
+	if rec.Status != status || status != pages.PageStatus_PUBLISHED {
+		rec.PublishAt = state.PublishTime(status, publishAt, ts)
+	}
+	rec.Status = status
+	rec.Modified = ts
+	s.pages[id] = rec
+	s.event(id, pages.PageEventType_UPDATED, ts)
+	return clonePage(rec), nil
+}
+
+// PagePublishDue publishes every scheduled page whose publish time is at or
+// before ts and returns them.
+func (s *memory) PagePublishDue(ts int64) ([]*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []*pages.Page{}
+	for id, rec := range s.pages {
+		if rec.Status == pages.PageStatus_SCHEDULED && rec.PublishAt <= ts {
+			rec = clonePage(rec)
+			rec.Status = pages.PageStatus_PUBLISHED
+			rec.Modified = now()
+			s.pages[id] = rec
+			s.event(id, pages.PageEventType_UPDATED, rec.Modified)
+			out = append(out, clonePage(rec))
+		}
+	}
+	return out, nil
 }
 
 // PageDelete deletes an page for a given id.
 func (s *memory) PageDelete(id, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.canDelete(id, account); err != nil {
+		return err
+	}
+	s.pageDelete(id)
+	return nil
+}
+
+func (s *memory) pageDelete(id string) *pages.Page {
+	rec := s.pages[id]
+	for _, attachment := range rec.Attachments {
+		delete(s.attachments, attachment.Id)
+	}
+	for cid, comment := range s.comments {
+		if comment.PageId == id {
+			delete(s.comments, cid)
+		}
+	}
+
+	// Attachments are deleted with the page so aren't kept in its tombstone.
+	ts := now()
+	dead := clonePage(rec)
+	dead.Attachments = nil
+	s.tombstones[id] = &tombstone{page: dead, revisions: s.revisions[id], deleted: ts}
+	delete(s.pages, id)
+	delete(s.revisions, id)
+	delete(s.links, id)
+	delete(s.collaborators, id)
+	delete(s.views, id)
+	delete(s.viewers, id)
+	s.unfile(id)
+	s.event(id, pages.PageEventType_DELETED, ts)
+	return clonePage(rec)
+}
+
+// PageRestore recreates a page with its original ID and timestamps, or
+// overwrites the text and visibility of the account's existing page with
+// that ID. IDs of other accounts' deleted pages can't be reused.
+func (s *memory) PageRestore(account string, page *pages.Page) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[page.Id]
+	if !ok {
+		if t, ok := s.tombstones[page.Id]; ok && t.page.Account.Id != account {
+			return nil, state.ErrPageUnauthorized
+		}
+		rec = &pages.Page{
+			Id:         page.Id,
+			Account:    s.accounts[account],
+			Text:       page.Text,
+			Created:    page.Created,
+			Modified:   page.Modified,
+			Visibility: page.Visibility,
+			Version:    1,
+			Status:     page.Status,
+			PublishAt:  page.PublishAt,
+		}
+		s.index(rec)
+		s.pages[rec.Id] = rec
+		s.revisions[rec.Id] = []revision{{rec.Text, rec.Modified}}
+		delete(s.tombstones, rec.Id)
+		s.event(rec.Id, pages.PageEventType_CREATED, now())
+		return clonePage(rec), nil
+	}
+	if rec.Account.Id != account {
+		return nil, state.ErrPageUnauthorized
+	}
+	rec = clonePage(rec)
+	if rec.Text != page.Text {
+		rec.Version++
+		s.revisions[rec.Id] = append(s.revisions[rec.Id], revision{page.Text, now()})
+	}
+	rec.Text = page.Text
+	rec.Visibility = page.Visibility
+	rec.Status = page.Status
+	rec.PublishAt = page.PublishAt
+	rec.Modified = page.Modified
+	s.index(rec)
+	s.pages[rec.Id] = rec
+	s.event(rec.Id, pages.PageEventType_UPDATED, now())
+	return clonePage(rec), nil
+}
+
+// PageVisibleAsOf returns a page as it was at ts if the viewer could see
+// it. Deleted pages can be seen by their owners and, if they weren't private,
+// everyone.
+func (s *memory) PageVisibleAsOf(id, viewer string, ts int64) (*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if rec, ok := s.pages[id]; ok && rec.Created <= ts {
+		if s.role(rec, viewer) != pages.Role_NONE || (state.PublishedAsOf(rec, ts) && rec.Visibility != pages.Visibility_PRIVATE) {
+			return asOf(rec, s.revisions[id], ts), nil
+		}
+	}
+	if t, ok := s.tombstones[id]; ok && t.page.Created <= ts && t.deleted > ts {
+		if t.page.Account.Id == viewer || (state.PublishedAsOf(t.page, ts) && t.page.Visibility != pages.Visibility_PRIVATE) {
+			return asOf(t.page, t.revisions, ts), nil
+		}
+	}
+	return nil, state.ErrPageNotFound
+}
+
+// PagesVisibleAsOf returns the pages that were public at ts along with every
+// page then belonging to or shared with the viewer, as they were at ts.
+func (s *memory) PagesVisibleAsOf(viewer string, ts int64) ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.Page{}
+	for id, rec := range s.pages {
+		if rec.Created > ts {
+			continue
+		}
+		if (rec.Visibility == pages.Visibility_PUBLIC && state.PublishedAsOf(rec, ts)) || s.role(rec, viewer) != pages.Role_NONE {
+			out = append(out, asOf(rec, s.revisions[id], ts))
+		}
+	}
+	for _, t := range s.tombstones {
+		if t.page.Created > ts || t.deleted <= ts {
+			continue
+		}
+		if (t.page.Visibility == pages.Visibility_PUBLIC && state.PublishedAsOf(t.page, ts)) || t.page.Account.Id == viewer {
+			out = append(out, asOf(t.page, t.revisions, ts))
+		}
+	}
+	return out, nil
+}
+
+// PageFork creates a page owned by account with the text, visibility and
+// attachments of another page.
+func (s *memory) PageFork(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	source, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	rec := s.pageCreate(account, source.Text, source.Visibility, status, publishAt)
+	rec.ForkedFrom = id
+	for _, attachment := range source.Attachments {
+		dup := *attachment
+		dup.Id = uniqueID()
+		dup.PageId = rec.Id
+		dup.Created = rec.Created
+		s.attachments[dup.Id] = &dup
+		rec.Attachments = append(rec.Attachments, &dup)
+	}
+	s.pages[rec.Id] = rec
+	return clonePage(rec), nil
+}
+
+// PageForks returns the pages forked from a page, oldest first.
+func (s *memory) PageForks(id string) ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.pages[id]; !ok {
+		return nil, state.ErrPageNotFound
+	}
+	out := []*pages.Page{}
+	for _, rec := range s.pages {
+		if rec.ForkedFrom == id {
+			out = append(out, clonePage(rec))
+		}
+	}
+	sort.Sort(pagesByCreated(out))
+	return out, nil
+}
+
+// PageBatchCreate creates a page for every item.
+func (s *memory) PageBatchCreate(account string, items []*pages.PageCreateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pages.Page, len(items))
+	for i, item := range items {
+		out[i] = s.pageCreate(account, item.Text, item.Visibility, item.Status, item.PublishAt)
+	}
+	return out, make([]error, len(items)), nil
+}
+
+// PageBatchUpdate updates the page for every item. Every item is checked
+// before any are applied.
+func (s *memory) PageBatchUpdate(account string, items []*pages.PageUpdateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pages.Page, len(items))
+	errs := make([]error, len(items))
+	for i, item := range items {
+		errs[i] = s.canUpdate(item.Id, account, item.Visibility, state.RequestFields(item))
+	}
+	if atomic && state.AbortBatch(errs) {
+		return out, errs, nil
+	}
+	for i, item := range items {
+		if errs[i] == nil {
+			out[i] = s.pageUpdate(item.Id, item.Text, item.Visibility, state.RequestFields(item))
+		}
+	}
+	return out, errs, nil
+}
+
+// PageBatchDelete deletes every page. Every item is checked before any are
+// applied.
+func (s *memory) PageBatchDelete(account string, ids []string, atomic bool) ([]*pages.Page, []error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pages.Page, len(ids))
+	errs := make([]error, len(ids))
+	seen := make(map[string]bool)
+	for i, id := range ids {
+		if seen[id] {
+			errs[i] = state.ErrPageNotFound
+			continue
+		}
+		seen[id] = true
+		errs[i] = s.canDelete(id, account)
+	}
+	if atomic && state.AbortBatch(errs) {
+		return out, errs, nil
+	}
+	for i, id := range ids {
+		if errs[i] == nil {
+			out[i] = s.pageDelete(id)
+		}
+	}
+	return out, errs, nil
+}
+
+// PageRole returns the role an account has on a page. Collaborators have no
+// role until the page is published.
+func (s *memory) PageRole(id, account string) (pages.Role, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return pages.Role_NONE, state.ErrPageNotFound
+	}
+	return s.role(rec, account), nil
+}
+
+// PageCollaborators returns the accounts a page has been shared with.
+func (s *memory) PageCollaborators(id string) ([]*pages.Collaborator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.pages[id]; !ok {
+		return nil, state.ErrPageNotFound
+	}
+	out := []*pages.Collaborator{}
+	for _, rec := range s.collaborators[id] {
+		out = append(out, proto.Clone(rec).(*pages.Collaborator))
+	}
+	return out, nil
+}
+
+// PageShare grants a collaborator a role on a page. Only owners may share.
+func (s *memory) PageShare(id, account, collaborator string, role pages.Role) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	rec, ok := s.pages[id]
 	if !ok {
 		return state.ErrPageNotFound
 	}
-	if rec.Account.Id != account {
+	if s.role(rec, account) != pages.Role_OWNER {
 		return state.ErrPageUnauthorized
 	}
-	delete(s.pages, id)
+	collab, ok := s.accounts[collaborator]
+	if !ok {
+		return state.ErrAccountNotFound
+	}
+	if _, ok := s.collaborators[id]; !ok {
+		s.collaborators[id] = make(map[string]*pages.Collaborator)
+	}
+	s.change(id, pages.PageEventType_UPDATED, now())
+	if existing, ok := s.collaborators[id][collaborator]; ok {
+		existing.Role = role
+		return nil
+	}
+	s.collaborators[id][collaborator] = &pages.Collaborator{
+		Account: collab,
+		Role:    role,
+		Created: now(),
+	}
 	return nil
 }
 
-// Helpers
+// PageUnshare revokes a collaborator's access to a page. Only owners may
+// unshare.
+func (s *memory) PageUnshare(id, account, collaborator string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return state.ErrPageNotFound
+	}
+	if s.role(rec, account) != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	if _, ok := s.collaborators[id][collaborator]; !ok {
+		return state.ErrCollaboratorNotFound
+	}
+	delete(s.collaborators[id], collaborator)
+	s.change(id, pages.PageEventType_UPDATED, now())
+	return nil
+}
+
+// PageLock returns the unexpired edit lease on a page.
+func (s *memory) PageLock(id string) (*pages.PageLock, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.pages[id]
+	if !ok || !held(rec.Lock, now()) {
+		return nil, state.ErrLockNotFound
+	}
+	return proto.Clone(rec.Lock).(*pages.PageLock), nil
+}
+
+// PageLockAcquire gives an account an edit lease on a page until expires.
+// Only editors and owners may lock pages, and a lease held by another
+// account must expire first.
+func (s *memory) PageLockAcquire(id, account string, expires int64) (*pages.PageLock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	if s.role(rec, account) < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
+	}
+	ts := now()
+	acquired := ts
+	if held(rec.Lock, ts) {
+		if rec.Lock.Account.Id != account {
+			return nil, state.ErrPageLocked
+		}
+		acquired = rec.Lock.Acquired
+	}
+	rec = clonePage(rec)
+	rec.Lock = &pages.PageLock{
+		PageId:   id,
+		Account:  s.accounts[account],
+		Acquired: acquired,
+		Expires:  expires,
+	}
+	s.pages[id] = rec
+	s.change(id, pages.PageEventType_UPDATED, ts)
+	return proto.Clone(rec.Lock).(*pages.PageLock), nil
+}
+
+// PageLockRenew extends the account's edit lease on a page until expires.
+func (s *memory) PageLockRenew(id, account string, expires int64) (*pages.PageLock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	ts := now()
+	if !held(rec.Lock, ts) || rec.Lock.Account.Id != account {
+		return nil, state.ErrLockNotHeld
+	}
+	rec = clonePage(rec)
+	rec.Lock.Expires = expires
+	s.pages[id] = rec
+	s.change(id, pages.PageEventType_UPDATED, ts)
+	return proto.Clone(rec.Lock).(*pages.PageLock), nil
+}
+
+// PageLockRelease ends the edit lease on a page. Leases can be released by
+// their holder or the page's owner.
+func (s *memory) PageLockRelease(id, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return state.ErrPageNotFound
+	}
+	ts := now()
+	if !held(rec.Lock, ts) {
+		return state.ErrLockNotFound
+	}
+	if rec.Lock.Account.Id != account && rec.Account.Id != account {
+		return state.ErrLockNotHeld
+	}
+	rec = clonePage(rec)
+	rec.Lock = nil
+	s.pages[id] = rec
+	s.change(id, pages.PageEventType_UPDATED, ts)
+	return nil
+}
+
+// PageLocksExpire removes the edit leases that expired at or before ts and
+// returns the IDs of their pages.
+func (s *memory) PageLocksExpire(ts int64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := []string{}
+	for id, rec := range s.pages {
+		if rec.Lock != nil && !held(rec.Lock, ts) {
+			rec = clonePage(rec)
+			rec.Lock = nil
+			s.pages[id] = rec
+			s.change(id, pages.PageEventType_UPDATED, now())
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+// PageChanges returns the latest change to each page changed after cursor,
+// in sequence order.
+func (s *memory) PageChanges(cursor int64, limit int) ([]*pages.PageChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.PageChange{}
+	for _, change := range s.changes {
+		if change.Sequence > cursor {
+			rec := *change
+			if page, ok := s.pages[rec.PageId]; ok {
+				rec.Page = clonePage(page)
+			}
+			out = append(out, &rec)
+		}
+	}
+	sort.Sort(changesBySequence(out))
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// PageChange returns the latest change to a page.
+func (s *memory) PageChange(id string) (*pages.PageChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	change, ok := s.changes[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	rec := *change
+	return &rec, nil
+}
+
+// PageChangeLatest returns the latest change to any page.
+func (s *memory) PageChangeLatest() (*pages.PageChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec := pages.PageChange{}
+	for _, change := range s.changes {
+		if change.Sequence > rec.Sequence {
+			rec = *change
+		}
+	}
+	return &rec, nil
+}
+
+// PageEvents returns the events recorded after cursor, oldest first. Deleted
+// pages are taken from their tombstones.
+func (s *memory) PageEvents(cursor int64, limit int) ([]*pages.PageChange, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.PageChange{}
+	for _, event := range s.events {
+		if len(out) == limit {
+			break
+		}
+		if event.Sequence <= cursor {
+			continue
+		}
+		rec := *event
+		if page, ok := s.pages[rec.PageId]; ok {
+			rec.Page = clonePage(page)
+		} else if t, ok := s.tombstones[rec.PageId]; ok {
+			rec.Page = clonePage(t.page)
+		}
+		out = append(out, &rec)
+	}
+	return out, nil
+}
+
+// PageOutlinks returns the links from a page in the order they appear.
+func (s *memory) PageOutlinks(id string) ([]*pages.PageLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.pages[id]; !ok {
+		return nil, state.ErrPageNotFound
+	}
+	out := []*pages.PageLink{}
+	for _, ref := range s.links[id] {
+		link := &pages.PageLink{Ref: ref}
+		if page := s.resolve(ref); page != nil {
+			link.Page = clonePage(page)
+		}
+		out = append(out, link)
+	}
+	return out, nil
+}
+
+// PageBacklinks returns the links to a page, oldest linking page first.
+func (s *memory) PageBacklinks(id string) ([]*pages.PageLink, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.pages[id]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	out := []*pages.PageLink{}
+	for source, refs := range s.links {
+		for _, ref := range refs {
+			if s.resolve(ref) == rec {
+				out = append(out, &pages.PageLink{Ref: ref, Page: clonePage(s.pages[source])})
+				break
+			}
+		}
+	}
+	sort.Sort(linksByCreated(out))
+	return out, nil
+}
+
+// PageViewRecord counts a view of a page unless the viewer's last counted
+// view was within window of ts, and reports whether it was counted.
+func (s *memory) PageViewRecord(id, viewer string, ts, window int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.pages[id]; !ok {
+		return false, state.ErrPageNotFound
+	}
+	if _, ok := s.viewers[id]; !ok {
+		s.viewers[id] = make(map[string]int64)
+	}
+	for key, seen := range s.viewers[id] {
+		if seen <= ts-window {
+			delete(s.viewers[id], key)
+		}
+	}
+	if _, ok := s.viewers[id][viewer]; ok {
+		return false, nil
+	}
+	s.viewers[id][viewer] = ts
+	if _, ok := s.views[id]; !ok {
+		s.views[id] = make(map[int64]int64)
+	}
+	s.views[id][state.ViewDay(ts)]++
+	return true, nil
+}
+
+// PageViews returns a page's daily views since the given day, oldest first.
+func (s *memory) PageViews(id string, since int64) ([]*pages.PageViewBucket, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.pages[id]; !ok {
+		return nil, state.ErrPageNotFound
+	}
+	return buckets(s.views[id], since), nil
+}
+
+// PageViewsForAccount returns the daily views of all the account's pages
+// since the given day, oldest first.
+func (s *memory) PageViewsForAccount(account string, since int64) ([]*pages.PageViewBucket, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	days := make(map[int64]int64)
+	for id, views := range s.views {
+		if s.pages[id].Account.Id != account {
+			continue
+		}
+		for day, n := range views {
+			days[day] += n
+		}
+	}
+	return buckets(days, since), nil
+}
+
+// PageViewsTop returns up to limit of the account's pages with the most
+// views since the given day.
+func (s *memory) PageViewsTop(account string, since int64, limit int) ([]*pages.PageViewCount, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.PageViewCount{}
+	for id, views := range s.views {
+		rec := s.pages[id]
+		if rec.Account.Id != account {
+			continue
+		}
+		count := &pages.PageViewCount{Page: clonePage(rec)}
+		for day, n := range views {
+			if day >= since {
+				count.Views += n
+			}
+		}
+		if count.Views > 0 {
+			out = append(out, count)
+		}
+	}
+	sort.Sort(countsByViews(out))
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// Attachment returns an attachment for a given id.
+func (s *memory) Attachment(id string) (*pages.Attachment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.attachments[id]
+	if !ok {
+		return nil, state.ErrAttachmentNotFound
+	}
+	return proto.Clone(rec).(*pages.Attachment), nil
+}
+
+// AttachmentCreate records an attachment on a page. Only editors and owners
+// may attach files.
+func (s *memory) AttachmentCreate(pageID, account, name, contentType, hash string, size int64) (*pages.Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page, ok := s.pages[pageID]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	if s.role(page, account) < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
+	}
+	rec := pages.Attachment{
+		Id:          uniqueID(),
+		PageId:      pageID,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Sha256:      hash,
+		Created:     now(),
+	}
+	s.attachments[rec.Id] = &rec
+	page = clonePage(page)
+	page.Attachments = append(page.Attachments, &rec)
+	s.pages[pageID] = page
+	s.event(pageID, pages.PageEventType_UPDATED, rec.Created)
+	return proto.Clone(&rec).(*pages.Attachment), nil
+}
+
+// Comment returns a comment for a given id.
+func (s *memory) Comment(id string) (*pages.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.comments[id]
+	if !ok {
+		return nil, state.ErrCommentNotFound
+	}
+	return proto.Clone(rec).(*pages.Comment), nil
+}
+
+// Comments returns the comments on a page, oldest first.
+func (s *memory) Comments(pageID string) ([]*pages.Comment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.pages[pageID]; !ok {
+		return nil, state.ErrPageNotFound
+	}
+	out := []*pages.Comment{}
+	for _, rec := range s.comments {
+		if rec.PageId == pageID {
+			out = append(out, proto.Clone(rec).(*pages.Comment))
+		}
+	}
+	sort.Sort(commentsByCreated(out))
+	return out, nil
+}
+
+// CommentCreate adds a comment to a page, as a reply to parent if given.
+// Any account with a role on the page may comment.
+func (s *memory) CommentCreate(pageID, account, parent, text string, anchor *pages.CommentAnchor) (*pages.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page, ok := s.pages[pageID]
+	if !ok {
+		return nil, state.ErrPageNotFound
+	}
+	if s.role(page, account) == pages.Role_NONE {
+		return nil, state.ErrPageUnauthorized
+	}
+	if parent != "" {
+		if rec, ok := s.comments[parent]; !ok || rec.PageId != pageID {
+			return nil, state.ErrCommentNotFound
+		}
+	}
+	ts := now()
+	rec := pages.Comment{
+		Id:       uniqueID(),
+		PageId:   pageID,
+		ParentId: parent,
+		Account:  s.accounts[account],
+		Text:     text,
+		Anchor:   anchor,
+		Created:  ts,
+		Modified: ts,
+	}
+	s.comments[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.Comment), nil
+}
+
+// CommentUpdate replaces a comment's text. Only the comment's author may
+// edit it, and only while they still have a role on the page.
+func (s *memory) CommentUpdate(id, account, text string) (*pages.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.comments[id]
+	if !ok || rec.Deleted {
+		return nil, state.ErrCommentNotFound
+	}
+	if rec.Account.Id != account || s.role(s.pages[rec.PageId], account) == pages.Role_NONE {
+		return nil, state.ErrCommentUnauthorized
+	}
+	rec.Text = text
+	rec.Modified = now()
+	return proto.Clone(rec).(*pages.Comment), nil
+}
+
+// CommentDelete deletes a comment. Comments may be deleted by their author
+// or the page owner. Comments with replies are kept without their text, and
+// deleting the last reply to such a comment removes it too.
+func (s *memory) CommentDelete(id, account string) (*pages.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.comments[id]
+	if !ok || rec.Deleted {
+		return nil, state.ErrCommentNotFound
+	}
+	if rec.Account.Id != account && s.role(s.pages[rec.PageId], account) != pages.Role_OWNER {
+		return nil, state.ErrCommentUnauthorized
+	}
+	rec.Text = ""
+	rec.Deleted = true
+	rec.Modified = now()
+	for prune := rec; prune != nil && prune.Deleted && !s.replied(prune.Id); {
+		delete(s.comments, prune.Id)
+		prune = s.comments[prune.ParentId]
+	}
+	return proto.Clone(rec).(*pages.Comment), nil
+}
+
+// Template returns a template for a given id.
+func (s *memory) Template(id string) (*pages.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.templates[id]
+	if !ok {
+		return nil, state.ErrTemplateNotFound
+	}
+	return proto.Clone(rec).(*pages.Template), nil
+}
+
+// TemplatesForAccount returns the account's templates ordered by name.
+func (s *memory) TemplatesForAccount(account string) ([]*pages.Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.Template{}
+	for _, rec := range s.templates {
+		if rec.Account.Id == account {
+			out = append(out, proto.Clone(rec).(*pages.Template))
+		}
+	}
+	sort.Sort(templatesByName(out))
+	return out, nil
+}
+
+// TemplateCreate creates and returns a new template.
+func (s *memory) TemplateCreate(account, name, text string) (*pages.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := now()
+	rec := pages.Template{
+		Id:       uniqueID(),
+		Account:  s.accounts[account],
+		Name:     name,
+		Text:     text,
+		Fields:   template.Fields(text),
+		Created:  ts,
+		Modified: ts,
+	}
+	s.templates[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.Template), nil
+}
+
+// TemplateDelete deletes a template. Templates can only be deleted by the
+// account that created them.
+func (s *memory) TemplateDelete(id, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.templates[id]
+	if !ok || rec.Account.Id != account {
+		return state.ErrTemplateNotFound
+	}
+	delete(s.templates, id)
+	return nil
+}
+
+// ModerationDecision returns a moderation decision for a given id.
+func (s *memory) ModerationDecision(id string) (*pages.ModerationDecision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.decisions[id]
+	if !ok {
+		return nil, state.ErrDecisionNotFound
+	}
+	return proto.Clone(rec).(*pages.ModerationDecision), nil
+}
+
+// ModerationDecisions returns moderation decisions, newest first. A page
+// limits them to that page's decisions and pending to unreviewed ones.
+func (s *memory) ModerationDecisions(page string, pending bool) ([]*pages.ModerationDecision, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.ModerationDecision{}
+	for _, rec := range s.decisions {
+		if page != "" && rec.PageId != page {
+			continue
+		}
+		if pending && rec.Verdict != pages.ReviewVerdict_PENDING {
+			continue
+		}
+		out = append(out, proto.Clone(rec).(*pages.ModerationDecision))
+	}
+	sort.Sort(decisionsByCreated(out))
+	return out, nil
+}
+
+// ModerationRecord stores a new moderation decision.
+func (s *memory) ModerationRecord(decision *pages.ModerationDecision) (*pages.ModerationDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, err := s.account(decision.Account.Id)
+	if err != nil {
+		return nil, err
+	}
+	rec := *decision
+	rec.Id = uniqueID()
+	rec.Account = account
+	rec.Created = now()
+	if rec.Verdict != pages.ReviewVerdict_PENDING {
+		rec.Reviewed = rec.Created
+	}
+	s.decisions[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.ModerationDecision), nil
+}
+
+// ModerationReview records a reviewer's verdict on a moderation decision.
+func (s *memory) ModerationReview(id, reviewer string, verdict pages.ReviewVerdict) (*pages.ModerationDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.decisions[id]
+	if !ok {
+		return nil, state.ErrDecisionNotFound
+	}
+	rec.Verdict = verdict
+	rec.ReviewerId = reviewer
+	rec.Reviewed = now()
+	return proto.Clone(rec).(*pages.ModerationDecision), nil
+}
+
+// Webhook returns a webhook for a given id.
+func (s *memory) Webhook(id string) (*pages.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.webhooks[id]
+	if !ok {
+		return nil, state.ErrWebhookNotFound
+	}
+	return proto.Clone(rec).(*pages.Webhook), nil
+}
+
+// WebhooksForAccount returns the account's webhooks, oldest first.
+func (s *memory) WebhooksForAccount(account string) ([]*pages.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.Webhook{}
+	for _, rec := range s.webhooks {
+		if rec.Account.Id == account {
+			out = append(out, proto.Clone(rec).(*pages.Webhook))
+		}
+	}
+	sort.Sort(webhooksByCreated(out))
+	return out, nil
+}
+
+// WebhookCreate creates and returns a new webhook.
+func (s *memory) WebhookCreate(account, url, secret string, events []pages.PageEventType) (*pages.Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec := pages.Webhook{
+		Id:      uniqueID(),
+		Account: s.accounts[account],
+		Url:     url,
+		Events:  events,
+		Secret:  secret,
+		Created: now(),
+	}
+	s.webhooks[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.Webhook), nil
+}
+
+// WebhookDelete deletes a webhook and its deliveries. Webhooks can only be
+// deleted by the account that created them.
+func (s *memory) WebhookDelete(id, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.webhooks[id]
+	if !ok || rec.Account.Id != account {
+		return state.ErrWebhookNotFound
+	}
+	delete(s.webhooks, id)
+	for did, d := range s.deliveries {
+		if d.WebhookId == id {
+			delete(s.deliveries, did)
+		}
+	}
+	return nil
+}
+
+// WebhookDeliveries returns a webhook's most recent deliveries, newest
+// first.
+func (s *memory) WebhookDeliveries(webhook string, limit int) ([]*pages.WebhookDelivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.WebhookDelivery{}
+	for _, rec := range s.deliveries {
+		if rec.WebhookId == webhook {
+			out = append(out, proto.Clone(rec).(*pages.WebhookDelivery))
+		}
+	}
+	sort.Sort(sort.Reverse(deliveriesByCreated(out)))
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// WebhookDeliveriesDue returns up to limit queued deliveries whose next
+// attempt is at or before ts, oldest first.
+func (s *memory) WebhookDeliveriesDue(ts int64, limit int) ([]*pages.WebhookDelivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := []*pages.WebhookDelivery{}
+	for _, rec := range s.deliveries {
+		if rec.Status == pages.DeliveryStatus_QUEUED && rec.NextAttempt <= ts {
+			out = append(out, proto.Clone(rec).(*pages.WebhookDelivery))
+		}
+	}
+	sort.Sort(deliveriesByCreated(out))
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// WebhookDeliveryCreate queues a delivery of an event to a webhook for
+// immediate attempt.
+func (s *memory) WebhookDeliveryCreate(webhook string, event pages.PageEventType, payload string) (*pages.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.webhooks[webhook]; !ok {
+		return nil, state.ErrWebhookNotFound
+	}
+	ts := now()
+	rec := pages.WebhookDelivery{
+		Id:          uniqueID(),
+		WebhookId:   webhook,
+		Event:       event,
+		Payload:     payload,
+		NextAttempt: ts,
+		Created:     ts,
+		Modified:    ts,
+	}
+	s.deliveries[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.WebhookDelivery), nil
+}
+
+// WebhookDeliveryUpdate records the outcome of a delivery attempt.
+func (s *memory) WebhookDeliveryUpdate(delivery *pages.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.deliveries[delivery.Id]
+	if !ok {
+		return state.ErrWebhookNotFound
+	}
+	rec.Status = delivery.Status
+	rec.Attempts = delivery.Attempts
+	rec.NextAttempt = delivery.NextAttempt
+	rec.ResponseCode = delivery.ResponseCode
+	rec.Error = delivery.Error
+	rec.Modified = now()
+	return nil
+}
+
+// WebhookCursor returns the last page event queued for delivery.
+func (s *memory) WebhookCursor() (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.webhookCursor, nil
+}
+
+// WebhookCursorSet stores the webhook cursor and drops the events up to it.
+func (s *memory) WebhookCursorSet(seq int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.webhookCursor = seq
+	i := 0
+	for i < len(s.events) && s.events[i].Sequence <= seq {
+		i++
+	}
+	s.events = s.events[i:]
+	return nil
+}
+
+// Helpers
+
+// Notebook returns a notebook for a given id.
+func (s *memory) Notebook(id string) (*pages.Notebook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.notebooks[id]
+	if !ok {
+		return nil, state.ErrNotebookNotFound
+	}
+	return proto.Clone(rec).(*pages.Notebook), nil
+}
+
+// Notebooks returns the account's notebooks in a parent ordered by position.
+// Recursive lists include every notebook below the parent, depth first.
+func (s *memory) Notebooks(account, parent string, recursive bool) ([]*pages.Notebook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if parent != "" {
+		if _, err := s.ownNotebook(parent, account); err != nil {
+			return nil, err
+		}
+	}
+	out := []*pages.Notebook{}
+	var walk func(parent string)
+	walk = func(parent string) {
+		for _, rec := range s.children(account, parent) {
+			out = append(out, proto.Clone(rec).(*pages.Notebook))
+			if recursive {
+				walk(rec.Id)
+			}
+		}
+	}
+	walk(parent)
+	return out, nil
+}
+
+// NotebookCreate creates a notebook last in its parent.
+func (s *memory) NotebookCreate(account, name, parent string) (*pages.Notebook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if parent != "" {
+		if _, err := s.ownNotebook(parent, account); err != nil {
+			return nil, err
+		}
+	}
+	ts := now()
+	rec := pages.Notebook{
+		Id:       uniqueID(),
+		Account:  s.accounts[account],
+		Name:     name,
+		ParentId: parent,
+		Position: int64(len(s.children(account, parent))),
+		Created:  ts,
+		Modified: ts,
+	}
+	s.notebooks[rec.Id] = &rec
+	return proto.Clone(&rec).(*pages.Notebook), nil
+}
+
+// NotebookRename renames a notebook.
+func (s *memory) NotebookRename(id, account, name string) (*pages.Notebook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.ownNotebook(id, account)
+	if err != nil {
+		return nil, err
+	}
+	rec.Name = name
+	rec.Modified = now()
+	return proto.Clone(rec).(*pages.Notebook), nil
+}
+
+// NotebookMove moves a notebook to a position in a parent. Notebooks can't
+// be moved into themselves or their descendants.
+func (s *memory) NotebookMove(id, account, parent string, position int64) (*pages.Notebook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.ownNotebook(id, account)
+	if err != nil {
+		return nil, err
+	}
+	if parent != "" {
+		if _, err := s.ownNotebook(parent, account); err != nil {
+			return nil, err
+		}
+	}
+	for ancestor := parent; ancestor != ""; ancestor = s.notebooks[ancestor].ParentId {
+		if ancestor == id {
+			return nil, state.ErrNotebookCycle
+		}
+	}
+	renumber(without(s.children(account, rec.ParentId), rec))
+	siblings := without(s.children(account, parent), rec)
+	i := state.Place(position, len(siblings))
+	siblings = append(siblings[:i:i], append([]*pages.Notebook{rec}, siblings[i:]...)...)
+	rec.ParentId = parent
+	rec.Modified = now()
+	renumber(siblings)
+	return proto.Clone(rec).(*pages.Notebook), nil
+}
+
+// NotebookDelete deletes an empty notebook.
+func (s *memory) NotebookDelete(id, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, err := s.ownNotebook(id, account)
+	if err != nil {
+		return err
+	}
+	if len(s.children(account, id)) > 0 || len(s.notebookPages[id]) > 0 {
+		return state.ErrNotebookNotEmpty
+	}
+	delete(s.notebooks, id)
+	delete(s.notebookPages, id)
+	renumber(s.children(account, rec.ParentId))
+	return nil
+}
+
+// NotebookPages returns the pages in a notebook in order. Recursive lists
+// include the pages of every notebook below it, depth first.
+func (s *memory) NotebookPages(id string, recursive bool) ([]*pages.Page, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rec, ok := s.notebooks[id]
+	if !ok {
+		return nil, state.ErrNotebookNotFound
+	}
+	out := []*pages.Page{}
+	var walk func(notebook string)
+	walk = func(notebook string) {
+		for _, pageID := range s.notebookPages[notebook] {
+			out = append(out, clonePage(s.pages[pageID]))
+		}
+		if recursive {
+			for _, child := range s.children(rec.Account.Id, notebook) {
+				walk(child.Id)
+			}
+		}
+	}
+	walk(id)
+	return out, nil
+}
+
+// PageMove files a page at a position in a notebook, or takes it out of its
+// notebook if notebook is empty. Only owners may file pages.
+func (s *memory) PageMove(id, account, notebook string, position int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page, ok := s.pages[id]
+	if !ok {
+		return state.ErrPageNotFound
+	}
+	if page.Account.Id != account {
+		return state.ErrPageUnauthorized
+	}
+	if notebook != "" {
+		if _, err := s.ownNotebook(notebook, account); err != nil {
+			return err
+		}
+	}
+	s.unfile(id)
+	if notebook == "" {
+		return nil
+	}
+	ids := s.notebookPages[notebook]
+	i := state.Place(position, len(ids))
+	s.notebookPages[notebook] = append(ids[:i:i], append([]string{id}, ids[i:]...)...)
+	s.filed[id] = notebook
+	return nil
+}
+
+// children returns the account's notebooks in a parent ordered by position.
+func (s *memory) children(account, parent string) []*pages.Notebook {
+	out := []*pages.Notebook{}
+	for _, rec := range s.notebooks {
+		if rec.Account.Id == account && rec.ParentId == parent {
+			out = append(out, rec)
+		}
+	}
+	sort.Sort(notebooksByPosition(out))
+	return out
+}
+
+// ownNotebook returns a notebook if it belongs to the account.
+func (s *memory) ownNotebook(id, account string) (*pages.Notebook, error) {
+	rec, ok := s.notebooks[id]
+	if !ok || rec.Account.Id != account {
+		return nil, state.ErrNotebookNotFound
+	}
+	return rec, nil
+}
+
+// unfile takes a page out of its notebook.
+func (s *memory) unfile(id string) {
+	notebook, ok := s.filed[id]
+	if !ok {
+		return
+	}
+	ids := s.notebookPages[notebook]
+	for i, pageID := range ids {
+		if pageID == id {
+			s.notebookPages[notebook] = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+	delete(s.filed, id)
+}
+
+// change records a change to a page, replacing its earlier changes. Each
+// change takes the next sequence number.
+func (s *memory) change(id string, kind pages.PageEventType, ts int64) {
+	s.seq++
+	s.changes[id] = &pages.PageChange{
+		Sequence: s.seq,
+		Type:     kind,
+		PageId:   id,
+		Created:  ts,
+	}
+}
+
+// event records a change to a page along with an event for webhooks. Events
+// aren't replaced by later ones, so each is queued for delivery.
+func (s *memory) event(id string, kind pages.PageEventType, ts int64) {
+	s.change(id, kind, ts)
+	rec := *s.changes[id]
+	s.events = append(s.events, &rec)
+}
+
+// renumber sets the positions of notebooks to their order.
+func renumber(recs []*pages.Notebook) {
+	for i, rec := range recs {
+		rec.Position = int64(i)
+	}
+}
+
+// asOf returns a copy of a page with the text of its latest revision saved
+// by ts. Pages restored from an archive may have no revision that early, and
+// are given their first.
+func asOf(rec *pages.Page, revisions []revision, ts int64) *pages.Page {
+	out := clonePage(rec)
+	attachments := out.Attachments
+	out.Attachments = nil
+	out.Lock = nil
+	for _, attachment := range attachments {
+		if attachment.Created <= ts {
+			out.Attachments = append(out.Attachments, attachment)
+		}
+	}
+	if len(revisions) == 0 {
+		return out
+	}
+	i := 0
+	for i+1 < len(revisions) && revisions[i+1].created <= ts {
+		i++
+	}
+	out.Text = revisions[i].text
+	out.Title = wiki.Title(out.Text)
+	out.Version = int64(i + 1)
+	out.Modified = out.Created
+	if revisions[i].created > out.Modified && revisions[i].created <= ts {
+		out.Modified = revisions[i].created
+	}
+	return out
+}
+
+// clonePage returns a copy of a stored page that can be changed or handed
+// out without affecting the stored one.
+func clonePage(rec *pages.Page) *pages.Page {
+	return proto.Clone(rec).(*pages.Page)
+}
+
+// held reports whether a lease is unexpired at ts.
+func held(lock *pages.PageLock, ts int64) bool {
+	return lock != nil && lock.Expires > ts
+}
+
+// without returns recs without rec.
+func without(recs []*pages.Notebook, rec *pages.Notebook) []*pages.Notebook {
+	out := recs[:0]
+	for _, r := range recs {
+		if r != rec {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// index records a page's title and the links in its text.
+func (s *memory) index(rec *pages.Page) {
+	rec.Title = wiki.Title(rec.Text)
+	s.links[rec.Id] = wiki.Links(rec.Text)
+}
+
+// resolve returns the page a link refers to: the page with that ID, or else
+// the earliest created page with that title.
+func (s *memory) resolve(ref string) *pages.Page {
+	if rec, ok := s.pages[ref]; ok {
+		return rec
+	}
+	var out *pages.Page
+	for _, rec := range s.pages {
+		if !strings.EqualFold(rec.Title, ref) {
+			continue
+		}
+		if out == nil || rec.Created < out.Created || (rec.Created == out.Created && rec.Id < out.Id) {
+			out = rec
+		}
+	}
+	return out
+}
+
+// replied reports whether a comment has any replies.
+func (s *memory) replied(id string) bool {
+	for _, rec := range s.comments {
+		if rec.ParentId == id {
+			return true
+		}
+	}
+	return false
+}
+
+type commentsByCreated []*pages.Comment
+
+func (c commentsByCreated) Len() int      { return len(c) }
+func (c commentsByCreated) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c commentsByCreated) Less(i, j int) bool {
+	if c[i].Created == c[j].Created {
+		return c[i].Id < c[j].Id
+	}
+	return c[i].Created < c[j].Created
+}
+
+// buckets returns the daily view counts since the given day, oldest first.
+func buckets(days map[int64]int64, since int64) []*pages.PageViewBucket {
+	out := []*pages.PageViewBucket{}
+	for day, n := range days {
+		if day >= since {
+			out = append(out, &pages.PageViewBucket{Day: day, Views: n})
+		}
+	}
+	sort.Sort(bucketsByDay(out))
+	return out
+}
+
+type bucketsByDay []*pages.PageViewBucket
+
+func (b bucketsByDay) Len() int           { return len(b) }
+func (b bucketsByDay) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b bucketsByDay) Less(i, j int) bool { return b[i].Day < b[j].Day }
+
+type countsByViews []*pages.PageViewCount
+
+func (c countsByViews) Len() int      { return len(c) }
+func (c countsByViews) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c countsByViews) Less(i, j int) bool {
+	if c[i].Views == c[j].Views {
+		return c[i].Page.Id < c[j].Page.Id
+	}
+	return c[i].Views > c[j].Views
+}
+
+type templatesByName []*pages.Template
+
+func (t templatesByName) Len() int      { return len(t) }
+func (t templatesByName) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+func (t templatesByName) Less(i, j int) bool {
+	if t[i].Name == t[j].Name {
+		return t[i].Created < t[j].Created
+	}
+	return t[i].Name < t[j].Name
+}
+
+type decisionsByCreated []*pages.ModerationDecision
+
+func (d decisionsByCreated) Len() int           { return len(d) }
+func (d decisionsByCreated) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d decisionsByCreated) Less(i, j int) bool { return d[i].Created > d[j].Created }
+
+type webhooksByCreated []*pages.Webhook
+
+func (w webhooksByCreated) Len() int           { return len(w) }
+func (w webhooksByCreated) Swap(i, j int)      { w[i], w[j] = w[j], w[i] }
+func (w webhooksByCreated) Less(i, j int) bool { return w[i].Created < w[j].Created }
+
+type deliveriesByCreated []*pages.WebhookDelivery
+
+func (d deliveriesByCreated) Len() int           { return len(d) }
+func (d deliveriesByCreated) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d deliveriesByCreated) Less(i, j int) bool { return d[i].Created < d[j].Created }
+
+type changesBySequence []*pages.PageChange
+
+func (c changesBySequence) Len() int           { return len(c) }
+func (c changesBySequence) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c changesBySequence) Less(i, j int) bool { return c[i].Sequence < c[j].Sequence }
+
+type notebooksByPosition []*pages.Notebook
+
+func (n notebooksByPosition) Len() int      { return len(n) }
+func (n notebooksByPosition) Swap(i, j int) { n[i], n[j] = n[j], n[i] }
+func (n notebooksByPosition) Less(i, j int) bool {
+	if n[i].Position == n[j].Position {
+		return n[i].Created < n[j].Created
+	}
+	return n[i].Position < n[j].Position
+}
+
+type pagesByCreated []*pages.Page
+
+func (p pagesByCreated) Len() int           { return len(p) }
+func (p pagesByCreated) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p pagesByCreated) Less(i, j int) bool { return p[i].Created < p[j].Created }
+
+type linksByCreated []*pages.PageLink
+
+func (l linksByCreated) Len() int           { return len(l) }
+func (l linksByCreated) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l linksByCreated) Less(i, j int) bool { return l[i].Page.Created < l[j].Page.Created }
+
+// canEdit checks the page exists and the account may edit it.
+func (s *memory) canEdit(id, account string) error {
+	rec, ok := s.pages[id]
+	if !ok {
+		return state.ErrPageNotFound
+	}
+	if s.role(rec, account) < pages.Role_EDITOR {
+		return state.ErrPageUnauthorized
+	}
+	return nil
+}
+
+// canUpdate checks the page exists and the account may change the fields of
+// it. Only owners can change a page's visibility.
+func (s *memory) canUpdate(id, account string, visibility pages.Visibility, fields []string) error {
+	if err := s.canEdit(id, account); err != nil {
+		return err
+	}
+	rec := s.pages[id]
+	if state.HasField(fields, state.FieldVisibility) && visibility != rec.Visibility && s.role(rec, account) != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	return nil
+}
+
+// canDelete checks the page exists and the account may delete it.
+func (s *memory) canDelete(id, account string) error {
+	rec, ok := s.pages[id]
+	if !ok {
+		return state.ErrPageNotFound
+	}
+	if s.role(rec, account) != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	return nil
+}
+
+// role returns the account's role on the page, treating the author as owner.
+// Collaborators have no role until the page is published.
+func (s *memory) role(rec *pages.Page, account string) pages.Role {
+	if account == "" {
+		return pages.Role_NONE
+	}
+	if rec.Account.Id == account {
+		return pages.Role_OWNER
+	}
+	if rec.Status != pages.PageStatus_PUBLISHED {
+		return pages.Role_NONE
+	}
+	if collab, ok := s.collaborators[rec.Id][account]; ok {
+		return collab.Role
+	}
+	return pages.Role_NONE
+}
 
 func uniqueID() string {
 	return utils.RandSha1()
@@ -4,18 +4,29 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/nathanborror/pages/pages"
 	"github.com/nathanborror/pages/state"
+	"github.com/nathanborror/pages/template"
 	"github.com/nathanborror/pages/utils"
+	"github.com/nathanborror/pages/wiki"
 
 	_ "github.com/mattn/go-sqlite3" // sqlite driver
 )
 
 type sqlite struct {
-	db *sql.DB
+	db   dbtx
+	conn *sql.DB
+}
+
+// dbtx is satisfied by both *sql.DB and *sql.Tx so the same queries can run
+// inside a transaction.
+type dbtx interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Prepare(query string) (*sql.Stmt, error)
 }
 
 // New returns a Sqlite backed state interface.
@@ -36,20 +47,224 @@ func New() state.State {
 			password TEXT NOT NULL,
 			token TEXT NOT NULL default '',
 			created sqlite3_int64,
-			modified sqlite3_int64
+			modified sqlite3_int64,
+			plan TEXT NOT NULL default ''
 		);
 		CREATE TABLE IF NOT EXISTS page (
 			id TEXT PRIMARY KEY,
 			account TEXT NOT NULL default '',
 			text TEXT NOT NULL default '',
 			created sqlite3_int64,
+			modified sqlite3_int64,
+			visibility INTEGER NOT NULL default 0,
+			version INTEGER NOT NULL default 1,
+			title TEXT NOT NULL default '',
+			status INTEGER NOT NULL default 0,
+			publish_at sqlite3_int64 NOT NULL default 0,
+			forked_from TEXT NOT NULL default ''
+		);
+		CREATE TABLE IF NOT EXISTS page_link (
+			page TEXT NOT NULL,
+			ref TEXT NOT NULL,
+			PRIMARY KEY (page, ref)
+		);
+		CREATE INDEX IF NOT EXISTS page_link_ref ON page_link (ref);
+		CREATE TABLE IF NOT EXISTS page_change (
+			seq INTEGER PRIMARY KEY AUTOINCREMENT,
+			page TEXT NOT NULL UNIQUE,
+			type INTEGER NOT NULL default 0,
+			created sqlite3_int64
+		);
+		CREATE TABLE IF NOT EXISTS page_event (
+			seq INTEGER PRIMARY KEY AUTOINCREMENT,
+			page TEXT NOT NULL,
+			type INTEGER NOT NULL default 0,
+			created sqlite3_int64
+		);
+		CREATE TABLE IF NOT EXISTS page_revision (
+			page TEXT NOT NULL,
+			version INTEGER NOT NULL,
+			text TEXT NOT NULL default '',
+			created sqlite3_int64,
+			PRIMARY KEY (page, version)
+		);
+		CREATE TABLE IF NOT EXISTS page_collaborator (
+			page TEXT NOT NULL,
+			account TEXT NOT NULL,
+			role INTEGER NOT NULL default 0,
+			created sqlite3_int64,
+			PRIMARY KEY (page, account)
+		);
+		CREATE TABLE IF NOT EXISTS page_attachment (
+			id TEXT PRIMARY KEY,
+			page TEXT NOT NULL,
+			name TEXT NOT NULL default '',
+			content_type TEXT NOT NULL default '',
+			size INTEGER NOT NULL default 0,
+			sha256 TEXT NOT NULL,
+			created sqlite3_int64
+		);
+		CREATE TABLE IF NOT EXISTS page_comment (
+			id TEXT PRIMARY KEY,
+			page TEXT NOT NULL,
+			parent TEXT NOT NULL default '',
+			account TEXT NOT NULL,
+			text TEXT NOT NULL default '',
+			anchor_version INTEGER NOT NULL default 0,
+			anchor_start INTEGER NOT NULL default 0,
+			anchor_end INTEGER NOT NULL default 0,
+			created sqlite3_int64,
+			modified sqlite3_int64,
+			deleted INTEGER NOT NULL default 0
+		);
+		CREATE INDEX IF NOT EXISTS page_comment_page ON page_comment (page);
+		CREATE TABLE IF NOT EXISTS page_view (
+			page TEXT NOT NULL,
+			day sqlite3_int64 NOT NULL,
+			views INTEGER NOT NULL default 0,
+			PRIMARY KEY (page, day)
+		);
+		CREATE TABLE IF NOT EXISTS page_viewer (
+			page TEXT NOT NULL,
+			viewer TEXT NOT NULL,
+			seen sqlite3_int64,
+			PRIMARY KEY (page, viewer)
+		);
+		CREATE TABLE IF NOT EXISTS template (
+			id TEXT PRIMARY KEY,
+			account TEXT NOT NULL,
+			name TEXT NOT NULL default '',
+			text TEXT NOT NULL default '',
+			created sqlite3_int64,
+			modified sqlite3_int64
+		);
+		CREATE TABLE IF NOT EXISTS moderation_decision (
+			id TEXT PRIMARY KEY,
+			page TEXT NOT NULL default '',
+			account TEXT NOT NULL,
+			action INTEGER NOT NULL default 0,
+			checker TEXT NOT NULL default '',
+			reason TEXT NOT NULL default '',
+			text TEXT NOT NULL default '',
+			created sqlite3_int64,
+			status INTEGER NOT NULL default 0,
+			publish_at sqlite3_int64 NOT NULL default 0,
+			verdict INTEGER NOT NULL default 0,
+			reviewer TEXT NOT NULL default '',
+			reviewed sqlite3_int64 NOT NULL default 0
+		);
+		CREATE INDEX IF NOT EXISTS moderation_decision_page ON moderation_decision (page);
+		CREATE TABLE IF NOT EXISTS webhook (
+			id TEXT PRIMARY KEY,
+			account TEXT NOT NULL,
+			url TEXT NOT NULL,
+			events TEXT NOT NULL default '',
+			secret TEXT NOT NULL default '',
+			created sqlite3_int64
+		);
+		CREATE INDEX IF NOT EXISTS webhook_account ON webhook (account);
+		CREATE TABLE IF NOT EXISTS webhook_delivery (
+			id TEXT PRIMARY KEY,
+			webhook TEXT NOT NULL,
+			event INTEGER NOT NULL default 0,
+			payload TEXT NOT NULL default '',
+			status INTEGER NOT NULL default 0,
+			attempts INTEGER NOT NULL default 0,
+			next_attempt sqlite3_int64 NOT NULL default 0,
+			response_code INTEGER NOT NULL default 0,
+			error TEXT NOT NULL default '',
+			created sqlite3_int64,
+			modified sqlite3_int64
+		);
+		CREATE INDEX IF NOT EXISTS webhook_delivery_webhook ON webhook_delivery (webhook, created);
+		CREATE INDEX IF NOT EXISTS webhook_delivery_due ON webhook_delivery (status, next_attempt);
+		CREATE TABLE IF NOT EXISTS webhook_cursor (
+			id INTEGER PRIMARY KEY,
+			seq INTEGER NOT NULL default 0
+		);
+		CREATE TABLE IF NOT EXISTS notebook (
+			id TEXT PRIMARY KEY,
+			account TEXT NOT NULL,
+			name TEXT NOT NULL default '',
+			parent TEXT NOT NULL default '',
+			position INTEGER NOT NULL default 0,
+			created sqlite3_int64,
 			modified sqlite3_int64
+		);
+		CREATE INDEX IF NOT EXISTS notebook_parent ON notebook (account, parent, position);
+		CREATE TABLE IF NOT EXISTS notebook_page (
+			page TEXT PRIMARY KEY,
+			notebook TEXT NOT NULL,
+			position INTEGER NOT NULL default 0,
+			created sqlite3_int64
+		);
+		CREATE INDEX IF NOT EXISTS notebook_page_notebook ON notebook_page (notebook, position);
+		CREATE TABLE IF NOT EXISTS page_lock (
+			page TEXT PRIMARY KEY,
+			account TEXT NOT NULL,
+			acquired sqlite3_int64,
+			expires sqlite3_int64
+		);
+		CREATE INDEX IF NOT EXISTS page_lock_expires ON page_lock (expires);
+		CREATE TABLE IF NOT EXISTS page_tombstone (
+			page TEXT PRIMARY KEY,
+			account TEXT NOT NULL,
+			created sqlite3_int64,
+			visibility INTEGER NOT NULL default 0,
+			status INTEGER NOT NULL default 0,
+			publish_at sqlite3_int64 NOT NULL default 0,
+			forked_from TEXT NOT NULL default '',
+			deleted sqlite3_int64
 		)`
 	if _, err := db.Exec(tables); err != nil {
-		log.Fatalln("sqlite.New: Error creating tables: %s", err)
+		log.Fatalf("sqlite.New: Error creating tables: %s", err)
+	}
+
+	// Columns added after a table was first created. Sqlite has no
+	// 'ADD COLUMN IF NOT EXISTS' so errors for existing columns are ignored.
+	columns := []string{
+		"ALTER TABLE page ADD COLUMN visibility INTEGER NOT NULL default 0",
+		"ALTER TABLE page ADD COLUMN version INTEGER NOT NULL default 1",
+		"ALTER TABLE page ADD COLUMN status INTEGER NOT NULL default 0",
+		"ALTER TABLE account ADD COLUMN plan TEXT NOT NULL default ''",
+		"ALTER TABLE page ADD COLUMN forked_from TEXT NOT NULL default ''",
+	}
+	for _, column := range columns {
+		db.Exec(column)
 	}
+	s := &sqlite{db: db, conn: db}
 
-	return &sqlite{db: db}
+	// Pages stored before scheduling were published when they were created.
+	if _, err := db.Exec("ALTER TABLE page ADD COLUMN publish_at sqlite3_int64 NOT NULL default 0"); err == nil {
+		if _, err := db.Exec("UPDATE page SET publish_at = created"); err != nil {
+			log.Fatalf("sqlite.New: Error setting publish times: %s", err)
+		}
+	}
+	if _, err := db.Exec("CREATE INDEX IF NOT EXISTS page_status ON page (status, publish_at); CREATE INDEX IF NOT EXISTS page_forked_from ON page (forked_from, created)"); err != nil {
+		log.Fatalf("sqlite.New: Error creating indexes: %s", err)
+	}
+
+	// Pages stored before changes were recorded are given a change each, in
+	// the order they were last modified.
+	var changes int
+	if err := db.QueryRow("SELECT COUNT(*) FROM page_change").Scan(&changes); err != nil {
+		log.Fatalf("sqlite.New: Error counting changes: %s", err)
+	}
+	if changes == 0 {
+		if _, err := db.Exec("INSERT INTO page_change (page, type, created) SELECT id, ?, modified FROM page ORDER BY modified", pages.PageEventType_CREATED); err != nil {
+			log.Fatalf("sqlite.New: Error recording changes: %s", err)
+		}
+	}
+
+	// Pages stored before titles and links were indexed are indexed when
+	// the title column is added.
+	if _, err := db.Exec("ALTER TABLE page ADD COLUMN title TEXT NOT NULL default ''"); err == nil {
+		if err := s.reindex(); err != nil {
+			log.Fatalf("sqlite.New: Error indexing pages: %s", err)
+		}
+	}
+
+	return s
 }
 
 // Description returns a human readable string identifying the Storage backend in use.
@@ -60,7 +275,7 @@ func (s *sqlite) Description() string {
 // Account returns an account for a given id.
 func (s *sqlite) Account(id string) (*pages.Account, error) {
 	var rec pages.Account
-	stmt, err := s.db.Prepare("SELECT id,name,email,created,modified FROM account WHERE id = ?")
+	stmt, err := s.db.Prepare("SELECT " + accountColumns + " FROM account WHERE id = ?")
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +289,7 @@ func (s *sqlite) Account(id string) (*pages.Account, error) {
 // AccountForEmail returns an account for a given email address.
 func (s *sqlite) AccountForEmail(email string) (*pages.Account, error) {
 	var rec pages.Account
-	stmt, err := s.db.Prepare("SELECT id,name,email,created,modified FROM account WHERE email = ?")
+	stmt, err := s.db.Prepare("SELECT " + accountColumns + " FROM account WHERE email = ?")
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +303,7 @@ func (s *sqlite) AccountForEmail(email string) (*pages.Account, error) {
 // AccountForToken returns an account for a given token.
 func (s *sqlite) AccountForToken(token string) (*pages.Account, error) {
 	var rec pages.Account
-	stmt, err := s.db.Prepare("SELECT id,name,email,created,modified FROM account WHERE token = ?")
+	stmt, err := s.db.Prepare("SELECT " + accountColumns + " FROM account WHERE token = ?")
 	if err != nil {
 		return nil, err
 	}
@@ -144,148 +359,2572 @@ func (s *sqlite) AccountTokenSet(id string) (string, error) {
 	return token, nil
 }
 
+// AccountPlanSet moves an account to a quota plan.
+func (s *sqlite) AccountPlanSet(id, plan string) (*pages.Account, error) {
+	stmt, err := s.db.Prepare("UPDATE account SET plan = ?, modified = ? WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	res, err := stmt.Exec(plan, now(), id)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, state.ErrAccountNotFound
+	}
+	return s.Account(id)
+}
+
+// AccountUsage counts the pages an account owns and the bytes attached to
+// them.
+func (s *sqlite) AccountUsage(id string) (int64, int64, error) {
+	var count, storage int64
+	stmt, err := s.db.Prepare(`SELECT
+		(SELECT COUNT(*) FROM page WHERE account = ?),
+		(SELECT COALESCE(SUM(a.size), 0) FROM page_attachment a JOIN page p ON p.id = a.page WHERE p.account = ?)`)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := stmt.QueryRow(id, id).Scan(&count, &storage); err != nil {
+		return 0, 0, err
+	}
+	return count, storage, nil
+}
+
 // Pages returns all pages.
 func (s *sqlite) Pages() ([]*pages.Page, error) {
-	var (
-		recs       []*pages.Page
-		accountIDs []string
-	)
-	pageAccountMap := make(map[string]string)
+	return s.pagesWhere("")
+}
 
-	// Fetch pages
-	stmt, err := s.db.Prepare("SELECT id,account,text,created,modified FROM page")
+// PagesVisible returns all public pages along with every page belonging to
+// or shared with the viewer.
+func (s *sqlite) PagesVisible(viewer string) ([]*pages.Page, error) {
+	return s.pagesWhere("WHERE account = ? OR (status = ? AND (visibility = ? OR id IN (SELECT page FROM page_collaborator WHERE account = ?)))", viewer, pages.PageStatus_PUBLISHED, pages.Visibility_PUBLIC, viewer)
+}
+
+// PagesForAccount returns all pages authored by the account.
+func (s *sqlite) PagesForAccount(account string) ([]*pages.Page, error) {
+	return s.pagesWhere("WHERE account = ?", account)
+}
+
+// Page returns an page for a given id.
+func (s *sqlite) Page(id string) (*pages.Page, error) {
+	return s.pageWhere("WHERE id = ?", id)
+}
+
+// PageVisible returns a page for a given id if the viewer is allowed to read
+// it. Private pages not shared with the viewer, and other authors' drafts and
+// scheduled pages, are reported as not found.
+func (s *sqlite) PageVisible(id, viewer string) (*pages.Page, error) {
+	return s.pageWhere("WHERE id = ? AND (account = ? OR (status = ? AND (visibility != ? OR id IN (SELECT page FROM page_collaborator WHERE account = ?))))", id, viewer, pages.PageStatus_PUBLISHED, pages.Visibility_PRIVATE, viewer)
+}
+
+// PageCreate creates and returns a new page.
+func (s *sqlite) PageCreate(accountID, text string, visibility pages.Visibility, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	ts := now()
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO page (id,account,text,created,modified,visibility,version,status,publish_at) VALUES (?,?,?,?,?,?,1,?,?)")
 	if err != nil {
 		return nil, err
 	}
-	rows, err := stmt.Query()
-	defer rows.Close()
-	for rows.Next() {
-		var (
-			rec       pages.Page
-			accountID string
-		)
-		if err = rows.Scan(&rec.Id, &accountID, &rec.Text, &rec.Created, &rec.Modified); err != nil {
+	if _, err := stmt.Exec(id, accountID, text, ts, ts, visibility, status, state.PublishTime(status, publishAt, ts)); err != nil {
+		return nil, err
+	}
+	if err := s.revisionCreate(id, 1, text, ts); err != nil {
+		return nil, err
+	}
+	if err := s.index(id, text); err != nil {
+		return nil, err
+	}
+	if err := s.event(id, pages.PageEventType_CREATED, ts); err != nil {
+		return nil, err
+	}
+	return s.Page(id)
+}
+
+// PageUpdate updates the given fields and returns the updated page.
+func (s *sqlite) PageUpdate(id, account, text string, visibility pages.Visibility, fields []string) (*pages.Page, error) {
+	role, err := s.PageRole(id, account)
+	if err != nil {
+		return nil, err
+	}
+	if role < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
+	}
+	if state.HasField(fields, state.FieldVisibility) && role != pages.Role_OWNER {
+		current, err := s.Page(id)
+		if err != nil {
 			return nil, err
 		}
-		pageAccountMap[rec.Id] = accountID
-		recs = append(recs, &rec)
+		if current.Visibility != visibility {
+			return nil, state.ErrPageUnauthorized
+		}
 	}
-	if len(recs) == 0 {
-		return recs, nil
+	ts := now()
+	set := "modified = ?"
+	args := []interface{}{ts}
+	if state.HasField(fields, state.FieldText) {
+		set += ", text = ?, version = CASE WHEN text = ? THEN version ELSE version + 1 END"
+		args = append(args, text, text)
 	}
-
-	// Fetch accounts and apply them to page results
-	for _, id := range pageAccountMap {
-		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", id))
+	if state.HasField(fields, state.FieldVisibility) {
+		set += ", visibility = ?"
+		args = append(args, visibility)
 	}
-	accounts, err := s.accountsIn(accountIDs)
+	stmt, err := s.db.Prepare("UPDATE page SET " + set + " WHERE id = ?")
 	if err != nil {
 		return nil, err
 	}
-	for _, rec := range recs {
-		accountID := pageAccountMap[rec.Id]
-		account := accounts[accountID]
-		rec.Account = &account
+	if _, err := stmt.Exec(append(args, id)...); err != nil {
+		return nil, err
 	}
-	return recs, nil
+	if err := s.event(id, pages.PageEventType_UPDATED, ts); err != nil {
+		return nil, err
+	}
+	page, err := s.Page(id)
+	if err != nil {
+		return nil, err
+	}
+	if !state.HasField(fields, state.FieldText) {
+		return page, nil
+	}
+	if err := s.revisionCreate(id, page.Version, text, ts); err != nil {
+		return nil, err
+	}
+	if err := s.index(id, text); err != nil {
+		return nil, err
+	}
+	page.Title = wiki.Title(text)
+	return page, nil
 }
 
-// Page returns an page for a given id.
-func (s *sqlite) Page(id string) (*pages.Page, error) {
-	var (
-		rec     pages.Page
-		account pages.Account
-	)
-	stmt, err := s.db.Prepare("SELECT id,account,text,created,modified FROM page WHERE id = ?")
+// PagePatch replaces a page's text if the page is still at the given version.
+func (s *sqlite) PagePatch(id, account string, version int64, text string) (*pages.Page, error) {
+	role, err := s.PageRole(id, account)
 	if err != nil {
 		return nil, err
 	}
-	row := stmt.QueryRow(id)
-	if err = scanPage(row, &rec, &account); err != nil {
-		return nil, state.ErrPageNotFound
+	if role < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
 	}
-	if account.Id == "" {
-		return nil, fmt.Errorf("Could not retrieve account ID")
+	ts := now()
+	stmt, err := s.db.Prepare("UPDATE page SET text = ?, modified = ?, version = version + 1 WHERE id = ? AND version = ?")
+	if err != nil {
+		return nil, err
 	}
-	rec.Account, err = s.Account(account.Id)
+	res, err := stmt.Exec(text, ts, id, version)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, state.ErrPageStale
+	}
+	if err := s.revisionCreate(id, version+1, text, ts); err != nil {
+		return nil, err
+	}
+	if err := s.index(id, text); err != nil {
+		return nil, err
+	}
+	if err := s.event(id, pages.PageEventType_UPDATED, ts); err != nil {
+		return nil, err
+	}
+	return s.Page(id)
+}
+
+// PageRevision returns the text of a page as of the given version.
+func (s *sqlite) PageRevision(id string, version int64) (string, error) {
+	page, err := s.Page(id)
 	if err != nil {
-		return nil, fmt.Errorf("Could not retrieve page for ID '%s' (%s)", id, err)
+		return "", err
 	}
-	return &rec, nil
+	if page.Version == version {
+		return page.Text, nil
+	}
+	var text string
+	stmt, err := s.db.Prepare("SELECT text FROM page_revision WHERE page = ? AND version = ?")
+	if err != nil {
+		return "", err
+	}
+	if err = stmt.QueryRow(id, version).Scan(&text); err == sql.ErrNoRows {
+		return "", state.ErrRevisionNotFound
+	} else if err != nil {
+		return "", err
+	}
+	return text, nil
 }
 
-// PageCreate creates and returns a new page.
-func (s *sqlite) PageCreate(accountID, text string) (*pages.Page, error) {
+// PageStatusUpdate changes a page's publishing state. Only owners may
+// publish or unpublish.
+func (s *sqlite) PageStatusUpdate(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	role, err := s.PageRole(id, account)
+	if err != nil {
+		return nil, err
+	}
+	if role != pages.Role_OWNER {
+		return nil, state.ErrPageUnauthorized
+	}
 	ts := now()
-	id := uniqueID()
-	stmt, err := s.db.Prepare("INSERT INTO page (id,account,text,created,modified) VALUES (?,?,?,?,?)")
+	stmt, err := s.db.Prepare("UPDATE page SET publish_at = CASE WHEN status = ? AND ? = ? THEN publish_at ELSE ? END, status = ?, modified = ? WHERE id = ?")
 	if err != nil {
 		return nil, err
 	}
-	if _, err := stmt.Exec(id, accountID, text, ts, ts); err != nil {
+	if _, err := stmt.Exec(pages.PageStatus_PUBLISHED, status, pages.PageStatus_PUBLISHED, state.PublishTime(status, publishAt, ts), status, ts, id); err != nil {
+		return nil, err
+	}
+	if err := s.event(id, pages.PageEventType_UPDATED, ts); err != nil {
 		return nil, err
 	}
 	return s.Page(id)
 }
 
-// PageUpdate updates and returns the updated page.
-func (s *sqlite) PageUpdate(id, account, text string) (*pages.Page, error) {
-	ts := now()
-	stmt, err := s.db.Prepare("UPDATE page SET account = ?, text = ?, modified = ? WHERE id = ?")
+// PagePublishDue publishes every scheduled page whose publish time is at or
+// before ts and returns them.
+func (s *sqlite) PagePublishDue(ts int64) ([]*pages.Page, error) {
+	recs, err := s.pagesWhere("WHERE status = ? AND publish_at <= ? ORDER BY publish_at", pages.PageStatus_SCHEDULED, ts)
 	if err != nil {
 		return nil, err
 	}
-	if _, err := stmt.Exec(account, text, ts, id); err != nil {
+	stmt, err := s.db.Prepare("UPDATE page SET status = ?, modified = ? WHERE id = ? AND status = ?")
+	if err != nil {
 		return nil, err
 	}
-	return s.Page(id)
+	out := []*pages.Page{}
+	for _, rec := range recs {
+		modified := now()
+		res, err := stmt.Exec(pages.PageStatus_PUBLISHED, modified, rec.Id, pages.PageStatus_SCHEDULED)
+		if err != nil {
+			return nil, err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			continue
+		}
+		if err := s.event(rec.Id, pages.PageEventType_UPDATED, modified); err != nil {
+			return nil, err
+		}
+		rec.Status = pages.PageStatus_PUBLISHED
+		rec.Modified = modified
+		out = append(out, rec)
+	}
+	return out, nil
 }
 
 // PageDelete deletes an page for a given id.
 func (s *sqlite) PageDelete(id, account string) error {
-	stmt, err := s.db.Prepare("DELETE FROM page WHERE id = ? AND account = ?")
+	role, err := s.PageRole(id, account)
 	if err != nil {
 		return err
 	}
-	if _, err := stmt.Exec(id, account); err != nil {
-		return err
+	if role != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
 	}
-	return nil
-}
-
-// Helpers
-
-func uniqueID() string {
-	return utils.RandSha1()
-}
 
-func now() int64 {
-	return time.Now().UTC().UnixNano()
-}
-
-func scanAccount(row *sql.Row, rec *pages.Account) error {
-	err := row.Scan(&rec.Id, &rec.Name, &rec.Email, &rec.Created, &rec.Modified)
-	if err == sql.ErrNoRows {
-		return fmt.Errorf("Not found")
-	} else if err != nil {
+	// Revisions are kept with a tombstone so the page can be read as it was
+	// before it was deleted.
+	ts := now()
+	stmt, err := s.db.Prepare("INSERT OR REPLACE INTO page_tombstone (page,account,created,visibility,status,publish_at,forked_from,deleted) SELECT id,account,created,visibility,status,publish_at,forked_from,? FROM page WHERE id = ?")
+	if err != nil {
 		return err
 	}
-	return nil
+	if _, err := stmt.Exec(ts, id); err != nil {
+		return err
+	}
+	stmt, err = s.db.Prepare("DELETE FROM page WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(id); err != nil {
+		return err
+	}
+	for _, table := range []string{"page_collaborator", "page_attachment", "page_link", "page_comment", "page_view", "page_viewer", "notebook_page", "page_lock"} {
+		stmt, err = s.db.Prepare("DELETE FROM " + table + " WHERE page = ?")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id); err != nil {
+			return err
+		}
+	}
+	return s.event(id, pages.PageEventType_DELETED, ts)
 }
 
-func scanPage(row *sql.Row, rec *pages.Page, account *pages.Account) error {
-	err := row.Scan(&rec.Id, &account.Id, &rec.Text, &rec.Created, &rec.Modified)
-	if err == sql.ErrNoRows {
-		return fmt.Errorf("Account not found")
+// PageRestore recreates a page with its original ID and timestamps, or
+// overwrites the text and visibility of the account's existing page with
+// that ID. IDs of other accounts' deleted pages can't be reused.
+func (s *sqlite) PageRestore(account string, page *pages.Page) (*pages.Page, error) {
+	existing, err := s.Page(page.Id)
+	if err == state.ErrPageNotFound {
+		// Only the owner of a deleted page may reuse its ID, which replaces
+		// the deleted page's history.
+		var owner string
+		stmt, err := s.db.Prepare("SELECT account FROM page_tombstone WHERE page = ?")
+		if err != nil {
+			return nil, err
+		}
+		if err := stmt.QueryRow(page.Id).Scan(&owner); err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if owner != "" && owner != account {
+			return nil, state.ErrPageUnauthorized
+		}
+		for _, table := range []string{"page_revision", "page_tombstone"} {
+			stmt, err := s.db.Prepare("DELETE FROM " + table + " WHERE page = ?")
+			if err != nil {
+				return nil, err
+			}
+			if _, err := stmt.Exec(page.Id); err != nil {
+				return nil, err
+			}
+		}
+		stmt, err = s.db.Prepare("INSERT INTO page (id,account,text,created,modified,visibility,version,status,publish_at) VALUES (?,?,?,?,?,?,1,?,?)")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := stmt.Exec(page.Id, account, page.Text, page.Created, page.Modified, page.Visibility, page.Status, page.PublishAt); err != nil {
+			return nil, err
+		}
+		if err := s.revisionCreate(page.Id, 1, page.Text, page.Modified); err != nil {
+			return nil, err
+		}
+		if err := s.index(page.Id, page.Text); err != nil {
+			return nil, err
+		}
+		if err := s.event(page.Id, pages.PageEventType_CREATED, now()); err != nil {
+			return nil, err
+		}
+		return s.Page(page.Id)
 	} else if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	if existing.Account.Id != account {
+		return nil, state.ErrPageUnauthorized
+	}
+	stmt, err := s.db.Prepare("UPDATE page SET text = ?, visibility = ?, status = ?, publish_at = ?, modified = ?, version = CASE WHEN text = ? THEN version ELSE version + 1 END WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(page.Text, page.Visibility, page.Status, page.PublishAt, page.Modified, page.Text, page.Id); err != nil {
+		return nil, err
+	}
+	rec, err := s.Page(page.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revisionCreate(rec.Id, rec.Version, rec.Text, now()); err != nil {
+		return nil, err
+	}
+	if err := s.index(rec.Id, rec.Text); err != nil {
+		return nil, err
+	}
+	if err := s.event(rec.Id, pages.PageEventType_UPDATED, now()); err != nil {
+		return nil, err
+	}
+	rec.Title = wiki.Title(rec.Text)
+	return rec, nil
+}
+
+// PageVisibleAsOf returns a page as it was at ts if the viewer could see
+// it. Deleted pages can be seen by their owners and, if they weren't private,
+// everyone.
+func (s *sqlite) PageVisibleAsOf(id, viewer string, ts int64) (*pages.Page, error) {
+	recs, err := s.pagesWhere("WHERE id = ? AND created <= ? AND (account = ? OR (status = ? AND id IN (SELECT page FROM page_collaborator WHERE account = ?)) OR (status = ? AND publish_at <= ? AND visibility != ?))", id, ts, viewer, pages.PageStatus_PUBLISHED, viewer, pages.PageStatus_PUBLISHED, ts, pages.Visibility_PRIVATE)
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		recs, err = s.tombstonesWhere("WHERE page = ? AND created <= ? AND deleted > ? AND (account = ? OR (status = ? AND publish_at <= ? AND visibility != ?))", id, ts, ts, viewer, pages.PageStatus_PUBLISHED, ts, pages.Visibility_PRIVATE)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(recs) == 0 {
+		return nil, state.ErrPageNotFound
+	}
+	if err := s.asOf(recs[0], ts); err != nil {
+		return nil, err
+	}
+	return recs[0], nil
+}
+
+// PagesVisibleAsOf returns the pages that were public at ts along with every
+// page then belonging to or shared with the viewer, as they were at ts.
+func (s *sqlite) PagesVisibleAsOf(viewer string, ts int64) ([]*pages.Page, error) {
+	live, err := s.pagesWhere("WHERE created <= ? AND (account = ? OR (status = ? AND id IN (SELECT page FROM page_collaborator WHERE account = ?)) OR (status = ? AND publish_at <= ? AND visibility = ?))", ts, viewer, pages.PageStatus_PUBLISHED, viewer, pages.PageStatus_PUBLISHED, ts, pages.Visibility_PUBLIC)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.tombstonesWhere("WHERE created <= ? AND deleted > ? AND (account = ? OR (status = ? AND publish_at <= ? AND visibility = ?))", ts, ts, viewer, pages.PageStatus_PUBLISHED, ts, pages.Visibility_PUBLIC)
+	if err != nil {
+		return nil, err
+	}
+	recs := append(live, deleted...)
+	for _, rec := range recs {
+		if err := s.asOf(rec, ts); err != nil {
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+// PageFork creates a page owned by account with the text, visibility and
+// attachments of another page.
+func (s *sqlite) PageFork(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error) {
+	var forkID string
+	err := s.transact(func(tx *sqlite) error {
+		source, err := tx.Page(id)
+		if err != nil {
+			return err
+		}
+		page, err := tx.PageCreate(account, source.Text, source.Visibility, status, publishAt)
+		if err != nil {
+			return err
+		}
+		forkID = page.Id
+		stmt, err := tx.db.Prepare("UPDATE page SET forked_from = ? WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id, forkID); err != nil {
+			return err
+		}
+		stmt, err = tx.db.Prepare("INSERT INTO page_attachment (" + attachmentColumns + ") VALUES (?,?,?,?,?,?,?)")
+		if err != nil {
+			return err
+		}
+		for _, a := range source.Attachments {
+			if _, err := stmt.Exec(uniqueID(), forkID, a.Name, a.ContentType, a.Size, a.Sha256, page.Created); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.Page(forkID)
+}
+
+// PageForks returns the pages forked from a page, oldest first.
+func (s *sqlite) PageForks(id string) ([]*pages.Page, error) {
+	if _, err := s.Page(id); err != nil {
+		return nil, err
+	}
+	return s.pagesWhere("WHERE forked_from = ? ORDER BY created", id)
+}
+
+// PageBatchCreate creates a page for every item in a single transaction.
+func (s *sqlite) PageBatchCreate(account string, items []*pages.PageCreateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	out := make([]*pages.Page, len(items))
+	errs, err := s.batch(len(items), atomic, func(tx *sqlite, i int) (err error) {
+		out[i], err = tx.PageCreate(account, items[i].Text, items[i].Visibility, items[i].Status, items[i].PublishAt)
+		return err
+	})
+	return out, errs, err
+}
+
+// PageBatchUpdate updates the page for every item in a single transaction.
+func (s *sqlite) PageBatchUpdate(account string, items []*pages.PageUpdateRequest, atomic bool) ([]*pages.Page, []error, error) {
+	out := make([]*pages.Page, len(items))
+	errs, err := s.batch(len(items), atomic, func(tx *sqlite, i int) (err error) {
+		out[i], err = tx.PageUpdate(items[i].Id, account, items[i].Text, items[i].Visibility, state.RequestFields(items[i]))
+		return err
+	})
+	return out, errs, err
+}
+
+// PageBatchDelete deletes every page in a single transaction.
+func (s *sqlite) PageBatchDelete(account string, ids []string, atomic bool) ([]*pages.Page, []error, error) {
+	out := make([]*pages.Page, len(ids))
+	errs, err := s.batch(len(ids), atomic, func(tx *sqlite, i int) error {
+		page, err := tx.Page(ids[i])
+		if err != nil {
+			return err
+		}
+		if err := tx.PageDelete(ids[i], account); err != nil {
+			return err
+		}
+		out[i] = page
+		return nil
+	})
+	return out, errs, err
+}
+
+// batch runs fn for n items inside one transaction. Best effort batches wrap
+// each item in a savepoint so a failing item is undone on its own.
+func (s *sqlite) batch(n int, atomic bool, fn func(tx *sqlite, i int) error) ([]error, error) {
+	errs := make([]error, n)
+	conn, err := s.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+	tx := &sqlite{db: conn}
+	for i := 0; i < n; i++ {
+		if !atomic {
+			if _, err := conn.Exec("SAVEPOINT item"); err != nil {
+				conn.Rollback()
+				return nil, err
+			}
+		}
+		errs[i] = fn(tx, i)
+		if atomic {
+			if errs[i] != nil {
+				break
+			}
+			continue
+		}
+		if errs[i] != nil {
+			if _, err := conn.Exec("ROLLBACK TO item"); err != nil {
+				conn.Rollback()
+				return nil, err
+			}
+		}
+		if _, err := conn.Exec("RELEASE item"); err != nil {
+			conn.Rollback()
+			return nil, err
+		}
+	}
+	if atomic && state.AbortBatch(errs) {
+		return errs, conn.Rollback()
+	}
+	return errs, conn.Commit()
+}
+
+// PageRole returns the role an account has on a page. Collaborators have no
+// role until the page is published.
+func (s *sqlite) PageRole(id, account string) (pages.Role, error) {
+	var (
+		author string
+		status pages.PageStatus
+		role   pages.Role
+	)
+	stmt, err := s.db.Prepare("SELECT account,status FROM page WHERE id = ?")
+	if err != nil {
+		return pages.Role_NONE, err
+	}
+	if err = stmt.QueryRow(id).Scan(&author, &status); err == sql.ErrNoRows {
+		return pages.Role_NONE, state.ErrPageNotFound
+	} else if err != nil {
+		return pages.Role_NONE, err
+	}
+	if account == "" {
+		return pages.Role_NONE, nil
+	}
+	if author == account {
+		return pages.Role_OWNER, nil
+	}
+	if status != pages.PageStatus_PUBLISHED {
+		return pages.Role_NONE, nil
+	}
+	stmt, err = s.db.Prepare("SELECT role FROM page_collaborator WHERE page = ? AND account = ?")
+	if err != nil {
+		return pages.Role_NONE, err
+	}
+	if err = stmt.QueryRow(id, account).Scan(&role); err == sql.ErrNoRows {
+		return pages.Role_NONE, nil
+	} else if err != nil {
+		return pages.Role_NONE, err
+	}
+	return role, nil
+}
+
+// PageCollaborators returns the accounts a page has been shared with.
+func (s *sqlite) PageCollaborators(id string) ([]*pages.Collaborator, error) {
+	if _, err := s.PageRole(id, ""); err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare("SELECT account,role,created FROM page_collaborator WHERE page = ?")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var (
+		recs       []*pages.Collaborator
+		accountIDs []string
+	)
+	collabAccountMap := make(map[*pages.Collaborator]string)
+	for rows.Next() {
+		var (
+			rec       pages.Collaborator
+			accountID string
+		)
+		if err := rows.Scan(&accountID, &rec.Role, &rec.Created); err != nil {
+			return nil, err
+		}
+		collabAccountMap[&rec] = accountID
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", accountID))
+		recs = append(recs, &rec)
+	}
+	if len(recs) == 0 {
+		return []*pages.Collaborator{}, nil
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		account := accounts[collabAccountMap[rec]]
+		rec.Account = &account
+	}
+	return recs, nil
+}
+
+// PageShare grants a collaborator a role on a page. Only owners may share.
+func (s *sqlite) PageShare(id, account, collaborator string, role pages.Role) error {
+	current, err := s.PageRole(id, account)
+	if err != nil {
+		return err
+	}
+	if current != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	if _, err := s.Account(collaborator); err != nil {
+		return err
+	}
+	stmt, err := s.db.Prepare("INSERT OR IGNORE INTO page_collaborator (page,account,role,created) VALUES (?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(id, collaborator, role, now()); err != nil {
+		return err
+	}
+	stmt, err = s.db.Prepare("UPDATE page_collaborator SET role = ? WHERE page = ? AND account = ?")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(role, id, collaborator); err != nil {
+		return err
+	}
+	return s.change(id, pages.PageEventType_UPDATED, now())
+}
+
+// PageUnshare revokes a collaborator's access to a page. Only owners may
+// unshare.
+func (s *sqlite) PageUnshare(id, account, collaborator string) error {
+	current, err := s.PageRole(id, account)
+	if err != nil {
+		return err
+	}
+	if current != pages.Role_OWNER {
+		return state.ErrPageUnauthorized
+	}
+	stmt, err := s.db.Prepare("DELETE FROM page_collaborator WHERE page = ? AND account = ?")
+	if err != nil {
+		return err
+	}
+	res, err := stmt.Exec(id, collaborator)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return state.ErrCollaboratorNotFound
+	}
+	return s.change(id, pages.PageEventType_UPDATED, now())
+}
+
+// PageLock returns the unexpired edit lease on a page.
+func (s *sqlite) PageLock(id string) (*pages.PageLock, error) {
+	locks, err := s.locksIn([]string{fmt.Sprintf("'%s'", id)})
+	if err != nil {
+		return nil, err
+	}
+	lock, ok := locks[id]
+	if !ok {
+		return nil, state.ErrLockNotFound
+	}
+	return lock, nil
+}
+
+// PageLockAcquire gives an account an edit lease on a page until expires.
+// Only editors and owners may lock pages, and a lease held by another
+// account must expire first.
+func (s *sqlite) PageLockAcquire(id, account string, expires int64) (*pages.PageLock, error) {
+	err := s.transact(func(tx *sqlite) error {
+		role, err := tx.PageRole(id, account)
+		if err != nil {
+			return err
+		}
+		if role < pages.Role_EDITOR {
+			return state.ErrPageUnauthorized
+		}
+		ts := now()
+		var (
+			holder   string
+			acquired = ts
+		)
+		stmt, err := tx.db.Prepare("SELECT account,acquired FROM page_lock WHERE page = ? AND expires > ?")
+		if err != nil {
+			return err
+		}
+		if err := stmt.QueryRow(id, ts).Scan(&holder, &acquired); err == sql.ErrNoRows {
+			acquired = ts
+		} else if err != nil {
+			return err
+		} else if holder != account {
+			return state.ErrPageLocked
+		}
+		stmt, err = tx.db.Prepare("INSERT OR REPLACE INTO page_lock (page, account, acquired, expires) VALUES (?,?,?,?)")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id, account, acquired, expires); err != nil {
+			return err
+		}
+		return tx.change(id, pages.PageEventType_UPDATED, ts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.PageLock(id)
+}
+
+// PageLockRenew extends the account's edit lease on a page until expires.
+func (s *sqlite) PageLockRenew(id, account string, expires int64) (*pages.PageLock, error) {
+	ts := now()
+	stmt, err := s.db.Prepare("UPDATE page_lock SET expires = ? WHERE page = ? AND account = ? AND expires > ?")
+	if err != nil {
+		return nil, err
+	}
+	res, err := stmt.Exec(expires, id, account, ts)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, state.ErrLockNotHeld
+	}
+	if err := s.change(id, pages.PageEventType_UPDATED, ts); err != nil {
+		return nil, err
+	}
+	return s.PageLock(id)
+}
+
+// PageLockRelease ends the edit lease on a page. Leases can be released by
+// their holder or the page's owner.
+func (s *sqlite) PageLockRelease(id, account string) error {
+	return s.transact(func(tx *sqlite) error {
+		lock, err := tx.PageLock(id)
+		if err != nil {
+			return err
+		}
+		if lock.Account.Id != account {
+			var owner string
+			stmt, err := tx.db.Prepare("SELECT account FROM page WHERE id = ?")
+			if err != nil {
+				return err
+			}
+			if err := stmt.QueryRow(id).Scan(&owner); err != nil {
+				return err
+			}
+			if owner != account {
+				return state.ErrLockNotHeld
+			}
+		}
+		stmt, err := tx.db.Prepare("DELETE FROM page_lock WHERE page = ?")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id); err != nil {
+			return err
+		}
+		return tx.change(id, pages.PageEventType_UPDATED, now())
+	})
+}
+
+// PageLocksExpire removes the edit leases that expired at or before ts and
+// returns the IDs of their pages.
+func (s *sqlite) PageLocksExpire(ts int64) ([]string, error) {
+	var out []string
+	err := s.transact(func(tx *sqlite) error {
+		ids, err := tx.ids("SELECT page FROM page_lock WHERE expires <= ?", ts)
+		if err != nil {
+			return err
+		}
+		stmt, err := tx.db.Prepare("DELETE FROM page_lock WHERE page = ?")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if _, err := stmt.Exec(id); err != nil {
+				return err
+			}
+			if err := tx.change(id, pages.PageEventType_UPDATED, now()); err != nil {
+				return err
+			}
+		}
+		out = ids
+		return nil
+	})
+	return out, err
+}
+
+// PageChanges returns the latest change to each page changed after cursor,
+// in sequence order.
+func (s *sqlite) PageChanges(cursor int64, limit int) ([]*pages.PageChange, error) {
+	stmt, err := s.db.Prepare("SELECT seq,page,type,created FROM page_change WHERE seq > ? ORDER BY seq LIMIT ?")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []*pages.PageChange{}
+	for rows.Next() {
+		rec := pages.PageChange{}
+		if err := rows.Scan(&rec.Sequence, &rec.PageId, &rec.Type, &rec.Created); err != nil {
+			return nil, err
+		}
+		out = append(out, &rec)
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+	recs, err := s.pagesWhere("WHERE id IN (SELECT page FROM page_change WHERE seq > ? ORDER BY seq LIMIT ?)", cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*pages.Page)
+	for _, rec := range recs {
+		byID[rec.Id] = rec
+	}
+	for _, rec := range out {
+		if rec.Type != pages.PageEventType_DELETED {
+			rec.Page = byID[rec.PageId]
+		}
+	}
+	return out, nil
+}
+
+// PageChange returns the latest change to a page.
+func (s *sqlite) PageChange(id string) (*pages.PageChange, error) {
+	return s.changeWhere("WHERE page = ?", id)
+}
+
+// PageChangeLatest returns the latest change to any page.
+func (s *sqlite) PageChangeLatest() (*pages.PageChange, error) {
+	rec, err := s.changeWhere("ORDER BY seq DESC LIMIT 1")
+	if err == state.ErrPageNotFound {
+		return &pages.PageChange{}, nil
+	}
+	return rec, err
+}
+
+// changeWhere returns the first change matching where.
+func (s *sqlite) changeWhere(where string, args ...interface{}) (*pages.PageChange, error) {
+	stmt, err := s.db.Prepare("SELECT seq,page,type,created FROM page_change " + where)
+	if err != nil {
+		return nil, err
+	}
+	rec := pages.PageChange{}
+	err = stmt.QueryRow(args...).Scan(&rec.Sequence, &rec.PageId, &rec.Type, &rec.Created)
+	if err == sql.ErrNoRows {
+		return nil, state.ErrPageNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// PageEvents returns the events recorded after cursor, oldest first. Deleted
+// pages are taken from their tombstones as they were when deleted.
+func (s *sqlite) PageEvents(cursor int64, limit int) ([]*pages.PageChange, error) {
+	stmt, err := s.db.Prepare("SELECT seq,page,type,created FROM page_event WHERE seq > ? ORDER BY seq LIMIT ?")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []*pages.PageChange{}
+	for rows.Next() {
+		rec := pages.PageChange{}
+		if err := rows.Scan(&rec.Sequence, &rec.PageId, &rec.Type, &rec.Created); err != nil {
+			return nil, err
+		}
+		out = append(out, &rec)
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+	in := "IN (SELECT page FROM page_event WHERE seq > ? ORDER BY seq LIMIT ?)"
+	live, err := s.pagesWhere("WHERE id "+in, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.tombstonesWhere("WHERE page "+in+" AND page NOT IN (SELECT id FROM page)", cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err = s.db.Prepare("SELECT deleted FROM page_tombstone WHERE page = ?")
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range deleted {
+		var ts int64
+		if err := stmt.QueryRow(rec.Id).Scan(&ts); err != nil {
+			return nil, err
+		}
+		if err := s.asOf(rec, ts); err != nil {
+			return nil, err
+		}
+	}
+	byID := make(map[string]*pages.Page)
+	for _, rec := range append(live, deleted...) {
+		byID[rec.Id] = rec
+	}
+	for _, rec := range out {
+		rec.Page = byID[rec.PageId]
+	}
+	return out, nil
+}
+
+// PageOutlinks returns the links from a page.
+func (s *sqlite) PageOutlinks(id string) ([]*pages.PageLink, error) {
+	if _, err := s.PageRole(id, ""); err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare("SELECT ref FROM page_link WHERE page = ? ORDER BY rowid")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(id)
+	if err != nil {
+		return nil, err
+	}
+	var refs []string
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	rows.Close()
+	out := []*pages.PageLink{}
+	for _, ref := range refs {
+		rec, err := s.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &pages.PageLink{Ref: ref, Page: rec})
+	}
+	return out, nil
+}
+
+// PageBacklinks returns the links to a page, oldest linking page first.
+// Links by title only count when the title resolves to this page.
+func (s *sqlite) PageBacklinks(id string) ([]*pages.PageLink, error) {
+	rec, err := s.Page(id)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare(`SELECT l.page, l.ref FROM page_link l JOIN page p ON p.id = l.page
+		WHERE l.ref = ? OR (l.ref = ? COLLATE NOCASE
+			AND NOT EXISTS (SELECT 1 FROM page WHERE id = l.ref)
+			AND (SELECT id FROM page WHERE title = l.ref COLLATE NOCASE ORDER BY created, id LIMIT 1) = ?)
+		ORDER BY p.created`)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(rec.Id, rec.Title, rec.Id)
+	if err != nil {
+		return nil, err
+	}
+	var sources, refs []string
+	for rows.Next() {
+		var source, ref string
+		if err := rows.Scan(&source, &ref); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		sources = append(sources, source)
+		refs = append(refs, ref)
+	}
+	rows.Close()
+	out := []*pages.PageLink{}
+	for i, source := range sources {
+		if i > 0 && sources[i-1] == source {
+			continue
+		}
+		page, err := s.Page(source)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &pages.PageLink{Ref: refs[i], Page: page})
+	}
+	return out, nil
+}
+
+// PageViewRecord counts a view of a page unless the viewer's last counted
+// view was within window of ts, and reports whether it was counted.
+func (s *sqlite) PageViewRecord(id, viewer string, ts, window int64) (bool, error) {
+	if _, err := s.PageRole(id, ""); err != nil {
+		return false, err
+	}
+	stmt, err := s.db.Prepare("DELETE FROM page_viewer WHERE page = ? AND seen <= ?")
+	if err != nil {
+		return false, err
+	}
+	if _, err := stmt.Exec(id, ts-window); err != nil {
+		return false, err
+	}
+	stmt, err = s.db.Prepare("INSERT OR IGNORE INTO page_viewer (page,viewer,seen) VALUES (?,?,?)")
+	if err != nil {
+		return false, err
+	}
+	res, err := stmt.Exec(id, viewer, ts)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+	day := state.ViewDay(ts)
+	stmt, err = s.db.Prepare("INSERT OR IGNORE INTO page_view (page,day,views) VALUES (?,?,0)")
+	if err != nil {
+		return false, err
+	}
+	if _, err := stmt.Exec(id, day); err != nil {
+		return false, err
+	}
+	stmt, err = s.db.Prepare("UPDATE page_view SET views = views + 1 WHERE page = ? AND day = ?")
+	if err != nil {
+		return false, err
+	}
+	if _, err := stmt.Exec(id, day); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// PageViews returns a page's daily views since the given day, oldest first.
+func (s *sqlite) PageViews(id string, since int64) ([]*pages.PageViewBucket, error) {
+	if _, err := s.PageRole(id, ""); err != nil {
+		return nil, err
+	}
+	return s.bucketsWhere("SELECT day,views FROM page_view WHERE page = ? AND day >= ? ORDER BY day", id, since)
+}
+
+// PageViewsForAccount returns the daily views of all the account's pages
+// since the given day, oldest first.
+func (s *sqlite) PageViewsForAccount(account string, since int64) ([]*pages.PageViewBucket, error) {
+	return s.bucketsWhere(`SELECT v.day, SUM(v.views) FROM page_view v JOIN page p ON p.id = v.page
+		WHERE p.account = ? AND v.day >= ? GROUP BY v.day ORDER BY v.day`, account, since)
+}
+
+// PageViewsTop returns up to limit of the account's pages with the most
+// views since the given day.
+func (s *sqlite) PageViewsTop(account string, since int64, limit int) ([]*pages.PageViewCount, error) {
+	stmt, err := s.db.Prepare(`SELECT v.page, SUM(v.views) AS n FROM page_view v JOIN page p ON p.id = v.page
+		WHERE p.account = ? AND v.day >= ? GROUP BY v.page ORDER BY n DESC, v.page LIMIT ?`)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(account, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ids    []string
+		counts []int64
+	)
+	for rows.Next() {
+		var (
+			id string
+			n  int64
+		)
+		if err := rows.Scan(&id, &n); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+		counts = append(counts, n)
+	}
+	rows.Close()
+	out := []*pages.PageViewCount{}
+	for i, id := range ids {
+		page, err := s.Page(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &pages.PageViewCount{Page: page, Views: counts[i]})
+	}
+	return out, nil
+}
+
+// Attachment returns an attachment for a given id.
+func (s *sqlite) Attachment(id string) (*pages.Attachment, error) {
+	var rec pages.Attachment
+	stmt, err := s.db.Prepare("SELECT " + attachmentColumns + " FROM page_attachment WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	err = stmt.QueryRow(id).Scan(&rec.Id, &rec.PageId, &rec.Name, &rec.ContentType, &rec.Size, &rec.Sha256, &rec.Created)
+	if err == sql.ErrNoRows {
+		return nil, state.ErrAttachmentNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// AttachmentCreate records an attachment on a page. Only editors and owners
+// may attach files.
+func (s *sqlite) AttachmentCreate(pageID, account, name, contentType, hash string, size int64) (*pages.Attachment, error) {
+	role, err := s.PageRole(pageID, account)
+	if err != nil {
+		return nil, err
+	}
+	if role < pages.Role_EDITOR {
+		return nil, state.ErrPageUnauthorized
+	}
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO page_attachment (" + attachmentColumns + ") VALUES (?,?,?,?,?,?,?)")
+	if err != nil {
+		return nil, err
+	}
+	ts := now()
+	if _, err := stmt.Exec(id, pageID, name, contentType, size, hash, ts); err != nil {
+		return nil, err
+	}
+	if err := s.event(pageID, pages.PageEventType_UPDATED, ts); err != nil {
+		return nil, err
+	}
+	return s.Attachment(id)
+}
+
+// Comment returns a comment for a given id.
+func (s *sqlite) Comment(id string) (*pages.Comment, error) {
+	var (
+		rec       pages.Comment
+		accountID string
+	)
+	stmt, err := s.db.Prepare("SELECT " + commentColumns + " FROM page_comment WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if err = scanComment(stmt.QueryRow(id), &rec, &accountID); err == sql.ErrNoRows {
+		return nil, state.ErrCommentNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rec.Account, err = s.Account(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// Comments returns the comments on a page, oldest first.
+func (s *sqlite) Comments(pageID string) ([]*pages.Comment, error) {
+	if _, err := s.PageRole(pageID, ""); err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare("SELECT " + commentColumns + " FROM page_comment WHERE page = ? ORDER BY created, id")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(pageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := []*pages.Comment{}
+	commentAccountMap := make(map[*pages.Comment]string)
+	var accountIDs []string
+	for rows.Next() {
+		var (
+			rec       pages.Comment
+			accountID string
+		)
+		if err := scanComment(rows, &rec, &accountID); err != nil {
+			return nil, err
+		}
+		commentAccountMap[&rec] = accountID
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", accountID))
+		recs = append(recs, &rec)
+	}
+	if len(recs) == 0 {
+		return recs, nil
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		account := accounts[commentAccountMap[rec]]
+		rec.Account = &account
+	}
+	return recs, nil
+}
+
+// CommentCreate adds a comment to a page, as a reply to parent if given.
+// Any account with a role on the page may comment.
+func (s *sqlite) CommentCreate(pageID, account, parent, text string, anchor *pages.CommentAnchor) (*pages.Comment, error) {
+	role, err := s.PageRole(pageID, account)
+	if err != nil {
+		return nil, err
+	}
+	if role == pages.Role_NONE {
+		return nil, state.ErrPageUnauthorized
+	}
+	if parent != "" {
+		rec, err := s.Comment(parent)
+		if err != nil {
+			return nil, err
+		}
+		if rec.PageId != pageID {
+			return nil, state.ErrCommentNotFound
+		}
+	}
+	if anchor == nil {
+		anchor = &pages.CommentAnchor{}
+	}
+	ts := now()
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO page_comment (" + commentColumns + ") VALUES (?,?,?,?,?,?,?,?,?,?,0)")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(id, pageID, parent, account, text, anchor.Version, anchor.Start, anchor.End, ts, ts); err != nil {
+		return nil, err
+	}
+	return s.Comment(id)
+}
+
+// CommentUpdate replaces a comment's text. Only the comment's author may
+// edit it, and only while they still have a role on the page.
+func (s *sqlite) CommentUpdate(id, account, text string) (*pages.Comment, error) {
+	rec, err := s.Comment(id)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Deleted {
+		return nil, state.ErrCommentNotFound
+	}
+	role, err := s.PageRole(rec.PageId, account)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Account.Id != account || role == pages.Role_NONE {
+		return nil, state.ErrCommentUnauthorized
+	}
+	stmt, err := s.db.Prepare("UPDATE page_comment SET text = ?, modified = ? WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(text, now(), id); err != nil {
+		return nil, err
+	}
+	return s.Comment(id)
+}
+
+// CommentDelete deletes a comment. Comments may be deleted by their author
+// or the page owner. Comments with replies are kept without their text, and
+// deleting the last reply to such a comment removes it too.
+func (s *sqlite) CommentDelete(id, account string) (*pages.Comment, error) {
+	rec, err := s.Comment(id)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Deleted {
+		return nil, state.ErrCommentNotFound
+	}
+	role, err := s.PageRole(rec.PageId, account)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Account.Id != account && role != pages.Role_OWNER {
+		return nil, state.ErrCommentUnauthorized
+	}
+	ts := now()
+	stmt, err := s.db.Prepare("UPDATE page_comment SET text = '', deleted = 1, modified = ? WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(ts, id); err != nil {
+		return nil, err
+	}
+	stmt, err = s.db.Prepare("SELECT parent FROM page_comment WHERE id = ? AND deleted = 1 AND NOT EXISTS (SELECT 1 FROM page_comment WHERE parent = ?)")
+	if err != nil {
+		return nil, err
+	}
+	for prune := id; prune != ""; {
+		var parent string
+		if err := stmt.QueryRow(prune, prune).Scan(&parent); err == sql.ErrNoRows {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if _, err := s.db.Exec("DELETE FROM page_comment WHERE id = ?", prune); err != nil {
+			return nil, err
+		}
+		prune = parent
+	}
+	rec.Text = ""
+	rec.Deleted = true
+	rec.Modified = ts
+	return rec, nil
+}
+
+// Template returns a template for a given id.
+func (s *sqlite) Template(id string) (*pages.Template, error) {
+	var (
+		rec       pages.Template
+		accountID string
+	)
+	stmt, err := s.db.Prepare("SELECT " + templateColumns + " FROM template WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if err = scanTemplate(stmt.QueryRow(id), &rec, &accountID); err == sql.ErrNoRows {
+		return nil, state.ErrTemplateNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rec.Account, err = s.Account(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// TemplatesForAccount returns the account's templates ordered by name.
+func (s *sqlite) TemplatesForAccount(account string) ([]*pages.Template, error) {
+	rec, err := s.Account(account)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare("SELECT " + templateColumns + " FROM template WHERE account = ? ORDER BY name, created")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := []*pages.Template{}
+	for rows.Next() {
+		var (
+			tmpl      pages.Template
+			accountID string
+		)
+		if err := scanTemplate(rows, &tmpl, &accountID); err != nil {
+			return nil, err
+		}
+		tmpl.Account = rec
+		recs = append(recs, &tmpl)
+	}
+	return recs, nil
+}
+
+// TemplateCreate creates and returns a new template.
+func (s *sqlite) TemplateCreate(account, name, text string) (*pages.Template, error) {
+	ts := now()
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO template (" + templateColumns + ") VALUES (?,?,?,?,?,?)")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(id, account, name, text, ts, ts); err != nil {
+		return nil, err
+	}
+	return s.Template(id)
+}
+
+// TemplateDelete deletes a template. Templates can only be deleted by the
+// account that created them.
+func (s *sqlite) TemplateDelete(id, account string) error {
+	stmt, err := s.db.Prepare("DELETE FROM template WHERE id = ? AND account = ?")
+	if err != nil {
+		return err
+	}
+	res, err := stmt.Exec(id, account)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return state.ErrTemplateNotFound
+	}
+	return nil
+}
+
+// ModerationDecision returns a moderation decision for a given id.
+func (s *sqlite) ModerationDecision(id string) (*pages.ModerationDecision, error) {
+	var (
+		rec       pages.ModerationDecision
+		accountID string
+	)
+	stmt, err := s.db.Prepare("SELECT " + decisionColumns + " FROM moderation_decision WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if err = scanDecision(stmt.QueryRow(id), &rec, &accountID); err == sql.ErrNoRows {
+		return nil, state.ErrDecisionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rec.Account, err = s.Account(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// ModerationDecisions returns moderation decisions, newest first. A page
+// limits them to that page's decisions and pending to unreviewed ones.
+func (s *sqlite) ModerationDecisions(page string, pending bool) ([]*pages.ModerationDecision, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if page != "" {
+		where = append(where, "page = ?")
+		args = append(args, page)
+	}
+	if pending {
+		where = append(where, "verdict = ?")
+		args = append(args, pages.ReviewVerdict_PENDING)
+	}
+	query := "SELECT " + decisionColumns + " FROM moderation_decision"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	stmt, err := s.db.Prepare(query + " ORDER BY created DESC, id")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := []*pages.ModerationDecision{}
+	decisionAccountMap := make(map[*pages.ModerationDecision]string)
+	var accountIDs []string
+	for rows.Next() {
+		var (
+			rec       pages.ModerationDecision
+			accountID string
+		)
+		if err := scanDecision(rows, &rec, &accountID); err != nil {
+			return nil, err
+		}
+		decisionAccountMap[&rec] = accountID
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", accountID))
+		recs = append(recs, &rec)
+	}
+	if len(recs) == 0 {
+		return recs, nil
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		account := accounts[decisionAccountMap[rec]]
+		rec.Account = &account
+	}
+	return recs, nil
+}
+
+// ModerationRecord stores a new moderation decision.
+func (s *sqlite) ModerationRecord(decision *pages.ModerationDecision) (*pages.ModerationDecision, error) {
+	if _, err := s.Account(decision.Account.Id); err != nil {
+		return nil, err
+	}
+	ts := now()
+	var reviewed int64
+	if decision.Verdict != pages.ReviewVerdict_PENDING {
+		reviewed = ts
+	}
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO moderation_decision (" + decisionColumns + ") VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return nil, err
+	}
+	d := decision
+	if _, err := stmt.Exec(id, d.PageId, d.Account.Id, d.Action, d.Checker, d.Reason, d.Text, ts, d.Status, d.PublishAt, d.Verdict, d.ReviewerId, reviewed); err != nil {
+		return nil, err
+	}
+	return s.ModerationDecision(id)
+}
+
+// ModerationReview records a reviewer's verdict on a moderation decision.
+func (s *sqlite) ModerationReview(id, reviewer string, verdict pages.ReviewVerdict) (*pages.ModerationDecision, error) {
+	stmt, err := s.db.Prepare("UPDATE moderation_decision SET verdict = ?, reviewer = ?, reviewed = ? WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	res, err := stmt.Exec(verdict, reviewer, now(), id)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, state.ErrDecisionNotFound
+	}
+	return s.ModerationDecision(id)
+}
+
+// Webhook returns a webhook for a given id.
+func (s *sqlite) Webhook(id string) (*pages.Webhook, error) {
+	var (
+		rec       pages.Webhook
+		accountID string
+	)
+	stmt, err := s.db.Prepare("SELECT " + webhookColumns + " FROM webhook WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if err = scanWebhook(stmt.QueryRow(id), &rec, &accountID); err == sql.ErrNoRows {
+		return nil, state.ErrWebhookNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rec.Account, err = s.Account(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// WebhooksForAccount returns the account's webhooks, oldest first.
+func (s *sqlite) WebhooksForAccount(account string) ([]*pages.Webhook, error) {
+	rec, err := s.Account(account)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := s.db.Prepare("SELECT " + webhookColumns + " FROM webhook WHERE account = ? ORDER BY created, id")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(account)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := []*pages.Webhook{}
+	for rows.Next() {
+		var (
+			hook      pages.Webhook
+			accountID string
+		)
+		if err := scanWebhook(rows, &hook, &accountID); err != nil {
+			return nil, err
+		}
+		hook.Account = rec
+		recs = append(recs, &hook)
+	}
+	return recs, nil
+}
+
+// WebhookCreate creates and returns a new webhook.
+func (s *sqlite) WebhookCreate(account, url, secret string, events []pages.PageEventType) (*pages.Webhook, error) {
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO webhook (" + webhookColumns + ") VALUES (?,?,?,?,?,?)")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(id, account, url, joinEvents(events), secret, now()); err != nil {
+		return nil, err
+	}
+	return s.Webhook(id)
+}
+
+// WebhookDelete deletes a webhook and its deliveries. Webhooks can only be
+// deleted by the account that created them.
+func (s *sqlite) WebhookDelete(id, account string) error {
+	stmt, err := s.db.Prepare("DELETE FROM webhook WHERE id = ? AND account = ?")
+	if err != nil {
+		return err
+	}
+	res, err := stmt.Exec(id, account)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return state.ErrWebhookNotFound
+	}
+	stmt, err = s.db.Prepare("DELETE FROM webhook_delivery WHERE webhook = ?")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(id)
+	return err
+}
+
+// WebhookDeliveries returns a webhook's most recent deliveries, newest
+// first.
+func (s *sqlite) WebhookDeliveries(webhook string, limit int) ([]*pages.WebhookDelivery, error) {
+	return s.deliveriesWhere("webhook = ? ORDER BY created DESC, id LIMIT ?", webhook, limit)
+}
+
+// WebhookDeliveriesDue returns up to limit queued deliveries whose next
+// attempt is at or before ts, oldest first.
+func (s *sqlite) WebhookDeliveriesDue(ts int64, limit int) ([]*pages.WebhookDelivery, error) {
+	return s.deliveriesWhere("status = ? AND next_attempt <= ? ORDER BY created, id LIMIT ?", pages.DeliveryStatus_QUEUED, ts, limit)
+}
+
+// WebhookDeliveryCreate queues a delivery of an event to a webhook for
+// immediate attempt.
+func (s *sqlite) WebhookDeliveryCreate(webhook string, event pages.PageEventType, payload string) (*pages.WebhookDelivery, error) {
+	if _, err := s.Webhook(webhook); err != nil {
+		return nil, err
+	}
+	ts := now()
+	rec := pages.WebhookDelivery{
+		Id:          uniqueID(),
+		WebhookId:   webhook,
+		Event:       event,
+		Payload:     payload,
+		NextAttempt: ts,
+		Created:     ts,
+		Modified:    ts,
+	}
+	stmt, err := s.db.Prepare("INSERT INTO webhook_delivery (" + deliveryColumns + ") VALUES (?,?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(rec.Id, rec.WebhookId, rec.Event, rec.Payload, rec.Status, rec.Attempts, rec.NextAttempt, rec.ResponseCode, rec.Error, rec.Created, rec.Modified); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// WebhookDeliveryUpdate records the outcome of a delivery attempt.
+func (s *sqlite) WebhookDeliveryUpdate(delivery *pages.WebhookDelivery) error {
+	stmt, err := s.db.Prepare("UPDATE webhook_delivery SET status = ?, attempts = ?, next_attempt = ?, response_code = ?, error = ?, modified = ? WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	d := delivery
+	res, err := stmt.Exec(d.Status, d.Attempts, d.NextAttempt, d.ResponseCode, d.Error, now(), d.Id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return state.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// WebhookCursor returns the last page event queued for delivery.
+func (s *sqlite) WebhookCursor() (int64, error) {
+	var seq int64
+	stmt, err := s.db.Prepare("SELECT seq FROM webhook_cursor WHERE id = 1")
+	if err != nil {
+		return 0, err
+	}
+	err = stmt.QueryRow().Scan(&seq)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return seq, err
+}
+
+// WebhookCursorSet stores the webhook cursor and deletes the events up to it.
+func (s *sqlite) WebhookCursorSet(seq int64) error {
+	stmt, err := s.db.Prepare("INSERT OR REPLACE INTO webhook_cursor (id, seq) VALUES (1, ?)")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(seq); err != nil {
+		return err
+	}
+	stmt, err = s.db.Prepare("DELETE FROM page_event WHERE seq <= ?")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(seq)
+	return err
+}
+
+// Helpers
+
+// Notebook returns a notebook for a given id.
+func (s *sqlite) Notebook(id string) (*pages.Notebook, error) {
+	var (
+		rec       pages.Notebook
+		accountID string
+	)
+	stmt, err := s.db.Prepare("SELECT " + notebookColumns + " FROM notebook WHERE id = ?")
+	if err != nil {
+		return nil, err
+	}
+	if err = scanNotebook(stmt.QueryRow(id), &rec, &accountID); err == sql.ErrNoRows {
+		return nil, state.ErrNotebookNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	rec.Account, err = s.Account(accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// Notebooks returns the account's notebooks in a parent ordered by position.
+// Recursive lists include every notebook below the parent, depth first.
+func (s *sqlite) Notebooks(account, parent string, recursive bool) ([]*pages.Notebook, error) {
+	if parent != "" {
+		if _, err := s.ownNotebook(parent, account); err != nil {
+			return nil, err
+		}
+	}
+	rec, err := s.Account(account)
+	if err != nil {
+		return nil, err
+	}
+	query := "SELECT " + notebookColumns + " FROM notebook WHERE account = ? AND parent = ? ORDER BY position, created"
+	if recursive {
+		// Each notebook's path is the positions of its ancestors and itself,
+		// so ordering by path lists the tree depth first.
+		query = `
+			WITH RECURSIVE tree(nid, path) AS (
+				SELECT id, printf('%010d', position) || id FROM notebook WHERE account = ? AND parent = ?
+				UNION ALL
+				SELECT id, path || '/' || printf('%010d', position) || id FROM notebook JOIN tree ON parent = nid
+			)
+			SELECT ` + notebookColumns + ` FROM notebook JOIN tree ON id = nid ORDER BY path`
+	}
+	stmt, err := s.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(account, parent)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recs := []*pages.Notebook{}
+	for rows.Next() {
+		var (
+			notebook  pages.Notebook
+			accountID string
+		)
+		if err := scanNotebook(rows, &notebook, &accountID); err != nil {
+			return nil, err
+		}
+		notebook.Account = rec
+		recs = append(recs, &notebook)
+	}
+	return recs, nil
+}
+
+// NotebookCreate creates a notebook last in its parent.
+func (s *sqlite) NotebookCreate(account, name, parent string) (*pages.Notebook, error) {
+	if parent != "" {
+		if _, err := s.ownNotebook(parent, account); err != nil {
+			return nil, err
+		}
+	}
+	ts := now()
+	id := uniqueID()
+	stmt, err := s.db.Prepare("INSERT INTO notebook (" + notebookColumns + ") SELECT ?,?,?,?,COUNT(*),?,? FROM notebook WHERE account = ? AND parent = ?")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stmt.Exec(id, account, name, parent, ts, ts, account, parent); err != nil {
+		return nil, err
+	}
+	return s.Notebook(id)
+}
+
+// NotebookRename renames a notebook.
+func (s *sqlite) NotebookRename(id, account, name string) (*pages.Notebook, error) {
+	stmt, err := s.db.Prepare("UPDATE notebook SET name = ?, modified = ? WHERE id = ? AND account = ?")
+	if err != nil {
+		return nil, err
+	}
+	res, err := stmt.Exec(name, now(), id, account)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, state.ErrNotebookNotFound
+	}
+	return s.Notebook(id)
+}
+
+// NotebookMove moves a notebook to a position in a parent. Notebooks can't
+// be moved into themselves or their descendants.
+func (s *sqlite) NotebookMove(id, account, parent string, position int64) (*pages.Notebook, error) {
+	err := s.transact(func(tx *sqlite) error {
+		rec, err := tx.ownNotebook(id, account)
+		if err != nil {
+			return err
+		}
+		if parent != "" {
+			if _, err := tx.ownNotebook(parent, account); err != nil {
+				return err
+			}
+			var cycle bool
+			stmt, err := tx.db.Prepare(`
+				WITH RECURSIVE up(nid) AS (
+					SELECT ?
+					UNION
+					SELECT parent FROM notebook JOIN up ON id = nid WHERE parent != ''
+				)
+				SELECT COUNT(*) > 0 FROM up WHERE nid = ?`)
+			if err != nil {
+				return err
+			}
+			if err := stmt.QueryRow(parent, id).Scan(&cycle); err != nil {
+				return err
+			}
+			if cycle {
+				return state.ErrNotebookCycle
+			}
+		}
+		old, err := tx.ids("SELECT id FROM notebook WHERE account = ? AND parent = ? AND id != ? ORDER BY position, created", account, rec.ParentId, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.positions("UPDATE notebook SET position = ? WHERE id = ?", old); err != nil {
+			return err
+		}
+		siblings, err := tx.ids("SELECT id FROM notebook WHERE account = ? AND parent = ? AND id != ? ORDER BY position, created", account, parent, id)
+		if err != nil {
+			return err
+		}
+		i := state.Place(position, len(siblings))
+		siblings = append(siblings[:i:i], append([]string{id}, siblings[i:]...)...)
+		stmt, err := tx.db.Prepare("UPDATE notebook SET parent = ?, modified = ? WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(parent, now(), id); err != nil {
+			return err
+		}
+		return tx.positions("UPDATE notebook SET position = ? WHERE id = ?", siblings)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.Notebook(id)
+}
+
+// NotebookDelete deletes an empty notebook.
+func (s *sqlite) NotebookDelete(id, account string) error {
+	return s.transact(func(tx *sqlite) error {
+		rec, err := tx.ownNotebook(id, account)
+		if err != nil {
+			return err
+		}
+		var empty bool
+		stmt, err := tx.db.Prepare("SELECT NOT EXISTS (SELECT 1 FROM notebook WHERE parent = ?) AND NOT EXISTS (SELECT 1 FROM notebook_page WHERE notebook = ?)")
+		if err != nil {
+			return err
+		}
+		if err := stmt.QueryRow(id, id).Scan(&empty); err != nil {
+			return err
+		}
+		if !empty {
+			return state.ErrNotebookNotEmpty
+		}
+		stmt, err = tx.db.Prepare("DELETE FROM notebook WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id); err != nil {
+			return err
+		}
+		siblings, err := tx.ids("SELECT id FROM notebook WHERE account = ? AND parent = ? ORDER BY position, created", account, rec.ParentId)
+		if err != nil {
+			return err
+		}
+		return tx.positions("UPDATE notebook SET position = ? WHERE id = ?", siblings)
+	})
+}
+
+// notebookPagesQuery selects the pages in a notebook and, recursively, the
+// notebooks below it, depth first.
+const notebookPagesQuery = `
+	WITH RECURSIVE tree(nid, path) AS (
+		SELECT ?, ''
+		UNION ALL
+		SELECT id, path || '/' || printf('%010d', position) || id FROM notebook JOIN tree ON parent = nid
+	)
+	SELECT page FROM notebook_page JOIN tree ON notebook = nid ORDER BY path, notebook_page.position`
+
+// NotebookPages returns the pages in a notebook in order. Recursive lists
+// include the pages of every notebook below it, depth first.
+func (s *sqlite) NotebookPages(id string, recursive bool) ([]*pages.Page, error) {
+	if _, err := s.Notebook(id); err != nil {
+		return nil, err
+	}
+	query := "SELECT page FROM notebook_page WHERE notebook = ? ORDER BY position"
+	if recursive {
+		query = notebookPagesQuery
+	}
+	ids, err := s.ids(query, id)
+	if err != nil {
+		return nil, err
+	}
+	recs, err := s.pagesWhere("WHERE id IN ("+query+")", id)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*pages.Page)
+	for _, rec := range recs {
+		byID[rec.Id] = rec
+	}
+	out := []*pages.Page{}
+	for _, id := range ids {
+		if rec, ok := byID[id]; ok {
+			out = append(out, rec)
+		}
+	}
+	return out, nil
+}
+
+// PageMove files a page at a position in a notebook, or takes it out of its
+// notebook if notebook is empty. Only owners may file pages.
+func (s *sqlite) PageMove(id, account, notebook string, position int64) error {
+	return s.transact(func(tx *sqlite) error {
+		var owner string
+		stmt, err := tx.db.Prepare("SELECT account FROM page WHERE id = ?")
+		if err != nil {
+			return err
+		}
+		if err := stmt.QueryRow(id).Scan(&owner); err == sql.ErrNoRows {
+			return state.ErrPageNotFound
+		} else if err != nil {
+			return err
+		}
+		if owner != account {
+			return state.ErrPageUnauthorized
+		}
+		if notebook != "" {
+			if _, err := tx.ownNotebook(notebook, account); err != nil {
+				return err
+			}
+		}
+
+		// Take the page out of its current notebook and close the gap.
+		var old string
+		stmt, err = tx.db.Prepare("SELECT notebook FROM notebook_page WHERE page = ?")
+		if err != nil {
+			return err
+		}
+		if err := stmt.QueryRow(id).Scan(&old); err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if old != "" {
+			stmt, err = tx.db.Prepare("DELETE FROM notebook_page WHERE page = ?")
+			if err != nil {
+				return err
+			}
+			if _, err := stmt.Exec(id); err != nil {
+				return err
+			}
+			ids, err := tx.ids("SELECT page FROM notebook_page WHERE notebook = ? ORDER BY position", old)
+			if err != nil {
+				return err
+			}
+			if err := tx.positions("UPDATE notebook_page SET position = ? WHERE page = ?", ids); err != nil {
+				return err
+			}
+		}
+		if notebook == "" {
+			return nil
+		}
+
+		ids, err := tx.ids("SELECT page FROM notebook_page WHERE notebook = ? ORDER BY position", notebook)
+		if err != nil {
+			return err
+		}
+		i := state.Place(position, len(ids))
+		ids = append(ids[:i:i], append([]string{id}, ids[i:]...)...)
+		stmt, err = tx.db.Prepare("INSERT INTO notebook_page (page, notebook, position, created) VALUES (?,?,?,?)")
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(id, notebook, i, now()); err != nil {
+			return err
+		}
+		return tx.positions("UPDATE notebook_page SET position = ? WHERE page = ?", ids)
+	})
+}
+
+// ownNotebook returns a notebook if it belongs to the account.
+func (s *sqlite) ownNotebook(id, account string) (*pages.Notebook, error) {
+	rec, err := s.Notebook(id)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Account.Id != account {
+		return nil, state.ErrNotebookNotFound
+	}
+	return rec, nil
+}
+
+// ids returns the first column of the rows a query selects.
+func (s *sqlite) ids(query string, args ...interface{}) ([]string, error) {
+	stmt, err := s.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// positions numbers ids by their order using update, which sets the
+// position of an ID.
+func (s *sqlite) positions(update string, ids []string) error {
+	stmt, err := s.db.Prepare(update)
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		if _, err := stmt.Exec(i, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transact runs fn in a transaction, which is committed if fn succeeds.
+func (s *sqlite) transact(fn func(tx *sqlite) error) error {
+	conn, err := s.conn.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(&sqlite{db: conn}); err != nil {
+		conn.Rollback()
+		return err
+	}
+	return conn.Commit()
+}
+
+func uniqueID() string {
+	return utils.RandSha1()
+}
+
+func now() int64 {
+	return time.Now().UTC().UnixNano()
+}
+
+const accountColumns = "id,name,email,created,modified,plan"
+
+func scanAccount(row *sql.Row, rec *pages.Account) error {
+	err := row.Scan(&rec.Id, &rec.Name, &rec.Email, &rec.Created, &rec.Modified, &rec.Plan)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("Not found")
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func scanPage(row *sql.Row, rec *pages.Page, account *pages.Account) error {
+	err := row.Scan(&rec.Id, &account.Id, &rec.Text, &rec.Created, &rec.Modified, &rec.Visibility, &rec.Version, &rec.Title, &rec.Status, &rec.PublishAt, &rec.ForkedFrom)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("Account not found")
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+const pageColumns = "id,account,text,created,modified,visibility,version,title,status,publish_at,forked_from"
+
+// pageWhere returns the first page matching the given where clause.
+func (s *sqlite) pageWhere(where string, args ...interface{}) (*pages.Page, error) {
+	var (
+		rec     pages.Page
+		account pages.Account
+	)
+	stmt, err := s.db.Prepare("SELECT " + pageColumns + " FROM page " + where)
+	if err != nil {
+		return nil, err
+	}
+	row := stmt.QueryRow(args...)
+	if err = scanPage(row, &rec, &account); err != nil {
+		return nil, state.ErrPageNotFound
+	}
+	if account.Id == "" {
+		return nil, fmt.Errorf("Could not retrieve account ID")
+	}
+	rec.Account, err = s.Account(account.Id)
+	if err != nil {
+		return nil, fmt.Errorf("Could not retrieve page for ID '%s' (%s)", rec.Id, err)
+	}
+	attachments, err := s.attachmentsIn([]string{fmt.Sprintf("'%s'", rec.Id)})
+	if err != nil {
+		return nil, err
+	}
+	rec.Attachments = attachments[rec.Id]
+	locks, err := s.locksIn([]string{fmt.Sprintf("'%s'", rec.Id)})
+	if err != nil {
+		return nil, err
+	}
+	rec.Lock = locks[rec.Id]
+	return &rec, nil
+}
+
+// pagesWhere returns all pages matching the given where clause with their
+// accounts applied.
+func (s *sqlite) pagesWhere(where string, args ...interface{}) ([]*pages.Page, error) {
+	var (
+		recs       []*pages.Page
+		accountIDs []string
+	)
+	pageAccountMap := make(map[string]string)
+
+	// Fetch pages
+	stmt, err := s.db.Prepare("SELECT " + pageColumns + " FROM page " + where)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			rec       pages.Page
+			accountID string
+		)
+		if err = rows.Scan(&rec.Id, &accountID, &rec.Text, &rec.Created, &rec.Modified, &rec.Visibility, &rec.Version, &rec.Title, &rec.Status, &rec.PublishAt, &rec.ForkedFrom); err != nil {
+			return nil, err
+		}
+		pageAccountMap[rec.Id] = accountID
+		recs = append(recs, &rec)
+	}
+	if len(recs) == 0 {
+		return recs, nil
+	}
+
+	// Fetch accounts and apply them to page results
+	for _, id := range pageAccountMap {
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", id))
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		accountID := pageAccountMap[rec.Id]
+		account := accounts[accountID]
+		rec.Account = &account
+	}
+
+	// Fetch attachments and apply them to page results
+	var pageIDs []string
+	for id := range pageAccountMap {
+		pageIDs = append(pageIDs, fmt.Sprintf("'%s'", id))
+	}
+	attachments, err := s.attachmentsIn(pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		rec.Attachments = attachments[rec.Id]
+	}
+
+	// Fetch edit leases and apply them to page results
+	locks, err := s.locksIn(pageIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		rec.Lock = locks[rec.Id]
+	}
+	return recs, nil
+}
+
+// index records a page's title and the links in its text.
+func (s *sqlite) index(id, text string) error {
+	stmt, err := s.db.Prepare("UPDATE page SET title = ? WHERE id = ?")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(wiki.Title(text), id); err != nil {
+		return err
+	}
+	stmt, err = s.db.Prepare("DELETE FROM page_link WHERE page = ?")
+	if err != nil {
+		return err
+	}
+	if _, err := stmt.Exec(id); err != nil {
+		return err
+	}
+	stmt, err = s.db.Prepare("INSERT INTO page_link (page,ref) VALUES (?,?)")
+	if err != nil {
+		return err
+	}
+	for _, ref := range wiki.Links(text) {
+		if _, err := stmt.Exec(id, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reindex indexes every page.
+func (s *sqlite) reindex() error {
+	recs, err := s.pagesWhere("")
+	if err != nil {
+		return err
+	}
+	for _, rec := range recs {
+		if err := s.index(rec.Id, rec.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve returns the page a link refers to: the page with that ID, or else
+// the earliest created page with that title.
+func (s *sqlite) resolve(ref string) (*pages.Page, error) {
+	rec, err := s.Page(ref)
+	if err != state.ErrPageNotFound {
+		return rec, err
+	}
+	rec, err = s.pageWhere("WHERE title = ? COLLATE NOCASE ORDER BY created, id LIMIT 1", ref)
+	if err == state.ErrPageNotFound {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// change records a change to a page, replacing its earlier changes. Each
+// change takes the next sequence number.
+func (s *sqlite) change(id string, kind pages.PageEventType, ts int64) error {
+	stmt, err := s.db.Prepare("INSERT OR REPLACE INTO page_change (page, type, created) VALUES (?,?,?)")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(id, kind, ts)
+	return err
+}
+
+// event records a change to a page along with an event for webhooks. Events
+// aren't replaced by later ones, so each is queued for delivery.
+func (s *sqlite) event(id string, kind pages.PageEventType, ts int64) error {
+	if err := s.change(id, kind, ts); err != nil {
+		return err
+	}
+	stmt, err := s.db.Prepare("INSERT INTO page_event (page, type, created) VALUES (?,?,?)")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(id, kind, ts)
+	return err
+}
+
+// revisionCreate records the text of a page version. Existing revisions are
+// left untouched.
+func (s *sqlite) revisionCreate(id string, version int64, text string, ts int64) error {
+	stmt, err := s.db.Prepare("INSERT OR IGNORE INTO page_revision (page,version,text,created) VALUES (?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(id, version, text, ts)
+	return err
+}
+
+const commentColumns = "id,page,parent,account,text,anchor_version,anchor_start,anchor_end,created,modified,deleted"
+
+// scanComment scans a comment row. Comments without an anchor are stored
+// with an anchor version of zero.
+func scanComment(row interface {
+	Scan(dest ...interface{}) error
+}, rec *pages.Comment, accountID *string) error {
+	var anchor pages.CommentAnchor
+	err := row.Scan(&rec.Id, &rec.PageId, &rec.ParentId, accountID, &rec.Text, &anchor.Version, &anchor.Start, &anchor.End, &rec.Created, &rec.Modified, &rec.Deleted)
+	if err != nil {
+		return err
+	}
+	if anchor.Version > 0 {
+		rec.Anchor = &anchor
+	}
+	return nil
+}
+
+const templateColumns = "id,account,name,text,created,modified"
+
+// scanTemplate scans a template row and derives its fields from its text.
+func scanTemplate(row interface {
+	Scan(dest ...interface{}) error
+}, rec *pages.Template, accountID *string) error {
+	if err := row.Scan(&rec.Id, accountID, &rec.Name, &rec.Text, &rec.Created, &rec.Modified); err != nil {
+		return err
+	}
+	rec.Fields = template.Fields(rec.Text)
+	return nil
+}
+
+const notebookColumns = "id,account,name,parent,position,created,modified"
+
+// scanNotebook scans a notebook row.
+func scanNotebook(row interface {
+	Scan(dest ...interface{}) error
+}, rec *pages.Notebook, accountID *string) error {
+	return row.Scan(&rec.Id, accountID, &rec.Name, &rec.ParentId, &rec.Position, &rec.Created, &rec.Modified)
+}
+
+const decisionColumns = "id,page,account,action,checker,reason,text,created,status,publish_at,verdict,reviewer,reviewed"
+
+func scanDecision(row interface {
+	Scan(dest ...interface{}) error
+}, rec *pages.ModerationDecision, accountID *string) error {
+	return row.Scan(&rec.Id, &rec.PageId, accountID, &rec.Action, &rec.Checker, &rec.Reason, &rec.Text, &rec.Created, &rec.Status, &rec.PublishAt, &rec.Verdict, &rec.ReviewerId, &rec.Reviewed)
+}
+
+const webhookColumns = "id,account,url,events,secret,created"
+
+// scanWebhook scans a webhook row. Events are stored as a comma separated
+// list of event type numbers.
+func scanWebhook(row interface {
+	Scan(dest ...interface{}) error
+}, rec *pages.Webhook, accountID *string) error {
+	var events string
+	if err := row.Scan(&rec.Id, accountID, &rec.Url, &events, &rec.Secret, &rec.Created); err != nil {
+		return err
+	}
+	for _, event := range strings.Split(events, ",") {
+		if n, err := strconv.Atoi(event); err == nil {
+			rec.Events = append(rec.Events, pages.PageEventType(n))
+		}
+	}
+	return nil
+}
+
+func joinEvents(events []pages.PageEventType) string {
+	out := make([]string, len(events))
+	for i, event := range events {
+		out[i] = strconv.Itoa(int(event))
+	}
+	return strings.Join(out, ",")
+}
+
+const deliveryColumns = "id,webhook,event,payload,status,attempts,next_attempt,response_code,error,created,modified"
+
+// deliveriesWhere returns the webhook deliveries matching a where clause.
+func (s *sqlite) deliveriesWhere(where string, args ...interface{}) ([]*pages.WebhookDelivery, error) {
+	stmt, err := s.db.Prepare("SELECT " + deliveryColumns + " FROM webhook_delivery WHERE " + where)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []*pages.WebhookDelivery{}
+	for rows.Next() {
+		rec := pages.WebhookDelivery{}
+		if err := rows.Scan(&rec.Id, &rec.WebhookId, &rec.Event, &rec.Payload, &rec.Status, &rec.Attempts, &rec.NextAttempt, &rec.ResponseCode, &rec.Error, &rec.Created, &rec.Modified); err != nil {
+			return nil, err
+		}
+		out = append(out, &rec)
+	}
+	return out, nil
+}
+
+// bucketsWhere returns the day and view count rows of the given query.
+func (s *sqlite) bucketsWhere(query string, args ...interface{}) ([]*pages.PageViewBucket, error) {
+	stmt, err := s.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []*pages.PageViewBucket{}
+	for rows.Next() {
+		rec := pages.PageViewBucket{}
+		if err := rows.Scan(&rec.Day, &rec.Views); err != nil {
+			return nil, err
+		}
+		out = append(out, &rec)
+	}
+	return out, nil
+}
+
+const attachmentColumns = "id,page,name,content_type,size,sha256,created"
+
+// attachmentsIn returns the attachments for the given pages keyed by page ID.
+// tombstonesWhere returns the deleted pages matching the given where clause
+// with their accounts applied. Pages are returned as they were when deleted,
+// without their text.
+func (s *sqlite) tombstonesWhere(where string, args ...interface{}) ([]*pages.Page, error) {
+	var (
+		recs       []*pages.Page
+		accountIDs []string
+	)
+	pageAccountMap := make(map[string]string)
+	stmt, err := s.db.Prepare("SELECT page,account,created,visibility,status,publish_at,forked_from FROM page_tombstone " + where)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			rec       pages.Page
+			accountID string
+		)
+		if err := rows.Scan(&rec.Id, &accountID, &rec.Created, &rec.Visibility, &rec.Status, &rec.PublishAt, &rec.ForkedFrom); err != nil {
+			return nil, err
+		}
+		pageAccountMap[rec.Id] = accountID
+		recs = append(recs, &rec)
+	}
+	if len(recs) == 0 {
+		return recs, nil
+	}
+	for _, id := range pageAccountMap {
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", id))
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		account := accounts[pageAccountMap[rec.Id]]
+		rec.Account = &account
+	}
+	return recs, nil
+}
+
+// asOf sets a page's text to that of its latest revision saved by ts and
+// leaves out attachments added and leases taken since. Pages restored from
+// an archive may have no revision that early, and are given their first.
+func (s *sqlite) asOf(rec *pages.Page, ts int64) error {
+	var (
+		version int64
+		text    string
+		created int64
+	)
+	stmt, err := s.db.Prepare("SELECT version,text,created FROM page_revision WHERE page = ? AND created <= ? ORDER BY version DESC LIMIT 1")
+	if err != nil {
+		return err
+	}
+	err = stmt.QueryRow(rec.Id, ts).Scan(&version, &text, &created)
+	if err == sql.ErrNoRows {
+		stmt, err = s.db.Prepare("SELECT version,text,created FROM page_revision WHERE page = ? ORDER BY version LIMIT 1")
+		if err != nil {
+			return err
+		}
+		err = stmt.QueryRow(rec.Id).Scan(&version, &text, &created)
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		rec.Text = text
+		rec.Title = wiki.Title(text)
+		rec.Version = version
+		rec.Modified = rec.Created
+		if created > rec.Modified && created <= ts {
+			rec.Modified = created
+		}
+	}
+	var attachments []*pages.Attachment
+	for _, attachment := range rec.Attachments {
+		if attachment.Created <= ts {
+			attachments = append(attachments, attachment)
+		}
+	}
+	rec.Attachments = attachments
+	rec.Lock = nil
+	return nil
+}
+
+// locksIn returns the unexpired edit leases on the given pages.
+func (s *sqlite) locksIn(pageIDs []string) (map[string]*pages.PageLock, error) {
+	locks := make(map[string]*pages.PageLock)
+	stmt, err := s.db.Prepare("SELECT page,account,acquired,expires FROM page_lock WHERE expires > ? AND page IN (" + strings.Join(pageIDs, ",") + ")")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query(now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	holders := make(map[string]string)
+	var accountIDs []string
+	for rows.Next() {
+		var (
+			rec       pages.PageLock
+			accountID string
+		)
+		if err := rows.Scan(&rec.PageId, &accountID, &rec.Acquired, &rec.Expires); err != nil {
+			return nil, err
+		}
+		locks[rec.PageId] = &rec
+		holders[rec.PageId] = accountID
+		accountIDs = append(accountIDs, fmt.Sprintf("'%s'", accountID))
+	}
+	if len(locks) == 0 {
+		return locks, nil
+	}
+	accounts, err := s.accountsIn(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	for id, rec := range locks {
+		account := accounts[holders[id]]
+		rec.Account = &account
+	}
+	return locks, nil
+}
+
+func (s *sqlite) attachmentsIn(pageIDs []string) (map[string][]*pages.Attachment, error) {
+	attachments := make(map[string][]*pages.Attachment)
+	stmt, err := s.db.Prepare("SELECT " + attachmentColumns + " FROM page_attachment WHERE page IN (" + strings.Join(pageIDs, ",") + ") ORDER BY created")
+	if err != nil {
+		return nil, err
+	}
+	rows, err := stmt.Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rec := pages.Attachment{}
+		if err := rows.Scan(&rec.Id, &rec.PageId, &rec.Name, &rec.ContentType, &rec.Size, &rec.Sha256, &rec.Created); err != nil {
+			return nil, err
+		}
+		attachments[rec.PageId] = append(attachments[rec.PageId], &rec)
+	}
+	return attachments, nil
 }
 
 func (s *sqlite) accountsIn(ids []string) (map[string]pages.Account, error) {
 	accounts := make(map[string]pages.Account)
-	stmt, err := s.db.Prepare("SELECT id,name,email,created,modified FROM account WHERE id IN (" + strings.Join(ids, ",") + ")")
+	stmt, err := s.db.Prepare("SELECT " + accountColumns + " FROM account WHERE id IN (" + strings.Join(ids, ",") + ")")
 	if err != nil {
 		return nil, err
 	}
@@ -296,7 +2935,7 @@ func (s *sqlite) accountsIn(ids []string) (map[string]pages.Account, error) {
 	defer rows.Close()
 	for rows.Next() {
 		rec := pages.Account{}
-		if err := rows.Scan(&rec.Id, &rec.Name, &rec.Email, &rec.Created, &rec.Modified); err != nil {
+		if err := rows.Scan(&rec.Id, &rec.Name, &rec.Email, &rec.Created, &rec.Modified, &rec.Plan); err != nil {
 			return nil, err
 		}
 		accounts[rec.Id] = rec
@@ -3,6 +3,8 @@ package state
 import (
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/nathanborror/pages/pages"
 )
@@ -19,6 +21,58 @@ var (
 
 	// ErrPageUnauthorized means the page does not belong to the account.
 	ErrPageUnauthorized = errors.New("Page does not belong to account")
+
+	// ErrPageStale means the page changed since the version the write was based on.
+	ErrPageStale = errors.New("Page has changed since the given version")
+
+	// ErrRevisionNotFound means the page has no revision for the given version.
+	ErrRevisionNotFound = errors.New("Page revision not found")
+
+	// ErrCollaboratorNotFound means the account is not a collaborator on the page.
+	ErrCollaboratorNotFound = errors.New("Collaborator not found")
+
+	// ErrBatchAborted means an atomic batch was rolled back because another
+	// item in it failed.
+	ErrBatchAborted = errors.New("Batch aborted")
+
+	// ErrAttachmentNotFound means the attachment wasn't found for the given identifier.
+	ErrAttachmentNotFound = errors.New("Attachment not found")
+
+	// ErrBlobNotFound means no blob is stored for the given hash.
+	ErrBlobNotFound = errors.New("Blob not found")
+
+	// ErrCommentNotFound means the comment wasn't found for the given identifier.
+	ErrCommentNotFound = errors.New("Comment not found")
+
+	// ErrCommentUnauthorized means the comment does not belong to the account.
+	ErrCommentUnauthorized = errors.New("Comment does not belong to account")
+
+	// ErrTemplateNotFound means the template wasn't found for the given identifier.
+	ErrTemplateNotFound = errors.New("Template not found")
+
+	// ErrDecisionNotFound means the moderation decision wasn't found for the given identifier.
+	ErrDecisionNotFound = errors.New("Moderation decision not found")
+
+	// ErrWebhookNotFound means the webhook wasn't found for the given identifier.
+	ErrWebhookNotFound = errors.New("Webhook not found")
+
+	// ErrNotebookNotFound means the notebook wasn't found for the given identifier.
+	ErrNotebookNotFound = errors.New("Notebook not found")
+
+	// ErrNotebookCycle means a notebook was moved into itself or one of its descendants.
+	ErrNotebookCycle = errors.New("Notebook can't be moved into itself")
+
+	// ErrNotebookNotEmpty means a notebook still has notebooks or pages in it.
+	ErrNotebookNotEmpty = errors.New("Notebook is not empty")
+
+	// ErrLockNotFound means the page has no unexpired edit lease.
+	ErrLockNotFound = errors.New("Page is not locked")
+
+	// ErrPageLocked means another account holds an edit lease on the page.
+	ErrPageLocked = errors.New("Page is locked by another account")
+
+	// ErrLockNotHeld means the account doesn't hold the page's edit lease.
+	ErrLockNotHeld = errors.New("Page lock is not held by account")
 )
 
 // State represents an interface for interacting with package types.
@@ -31,17 +85,249 @@ type State interface {
 	AccountForPassword(id, password string) (*pages.Account, error)
 	AccountTokenSet(id string) (string, error)
 	AccountCreate(name, email, password string) (*pages.Account, error)
+	AccountPlanSet(id, plan string) (*pages.Account, error)
+
+	// AccountUsage counts the pages an account owns and the bytes attached
+	// to them.
+	AccountUsage(id string) (pageCount, storage int64, err error)
 
 	// Pages
 	Pages() ([]*pages.Page, error)
+	PagesVisible(viewer string) ([]*pages.Page, error)
+	PagesForAccount(account string) ([]*pages.Page, error)
 	Page(id string) (*pages.Page, error)
-	PageCreate(account, text string) (*pages.Page, error)
-	PageUpdate(id, account, text string) (*pages.Page, error)
+	PageVisible(id, viewer string) (*pages.Page, error)
+	PageCreate(account, text string, visibility pages.Visibility, status pages.PageStatus, publishAt int64) (*pages.Page, error)
+
+	// PageUpdate changes the fields of a page. Editors can change its text,
+	// but only its owner can change its visibility.
+	PageUpdate(id, account, text string, visibility pages.Visibility, fields []string) (*pages.Page, error)
+	PagePatch(id, account string, version int64, text string) (*pages.Page, error)
+	PageRevision(id string, version int64) (string, error)
+	PageStatusUpdate(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error)
+	PagePublishDue(ts int64) ([]*pages.Page, error)
 	PageDelete(id, account string) error
+	PageRestore(account string, page *pages.Page) (*pages.Page, error)
+
+	// PageVisibleAsOf and PagesVisibleAsOf read pages visible to the viewer
+	// as they were at ts, including pages deleted since, from their
+	// revisions and deletion tombstones. Only text, title, version and
+	// modified time are historical; other fields are as last stored.
+	// Owners restoring a page with the ID of one of their deleted pages
+	// replace the deleted page's history; other accounts can't reuse it.
+	PageVisibleAsOf(id, viewer string, ts int64) (*pages.Page, error)
+	PagesVisibleAsOf(viewer string, ts int64) ([]*pages.Page, error)
+
+	// Forks are new pages owned by account with the text, visibility and
+	// attachments of the page they were forked from. PageForks returns them
+	// oldest first.
+	PageFork(id, account string, status pages.PageStatus, publishAt int64) (*pages.Page, error)
+	PageForks(id string) ([]*pages.Page, error)
+
+	// Batches return a page and an error for every item, in order. When
+	// atomic is set nothing is applied unless every item succeeds and the
+	// items that didn't fail report ErrBatchAborted.
+	PageBatchCreate(account string, items []*pages.PageCreateRequest, atomic bool) ([]*pages.Page, []error, error)
+	PageBatchUpdate(account string, items []*pages.PageUpdateRequest, atomic bool) ([]*pages.Page, []error, error)
+	PageBatchDelete(account string, ids []string, atomic bool) ([]*pages.Page, []error, error)
+
+	// Collaborators
+	PageRole(id, account string) (pages.Role, error)
+	PageCollaborators(id string) ([]*pages.Collaborator, error)
+	PageShare(id, account, collaborator string, role pages.Role) error
+	PageUnshare(id, account, collaborator string) error
+
+	// Locks are edit leases on pages, held by one account at a time until
+	// they expire at the given time. Expired leases are ignored and are
+	// removed by PageLocksExpire, which returns the IDs of their pages.
+	// Owners may release any lease on their pages.
+	PageLock(id string) (*pages.PageLock, error)
+	PageLockAcquire(id, account string, expires int64) (*pages.PageLock, error)
+	PageLockRenew(id, account string, expires int64) (*pages.PageLock, error)
+	PageLockRelease(id, account string) error
+	PageLocksExpire(ts int64) ([]string, error)
+
+	// PageChanges returns the latest change to each page changed after
+	// cursor, in sequence order. Every page mutation records a change, and
+	// deleted pages leave a tombstone change without a page.
+	PageChanges(cursor int64, limit int) ([]*pages.PageChange, error)
+
+	// PageChange returns the latest change to a page. PageChangeLatest
+	// returns the latest change to any page, which is empty before any page
+	// has changed.
+	PageChange(id string) (*pages.PageChange, error)
+	PageChangeLatest() (*pages.PageChange, error)
+
+	// PageEvents returns the events recorded after cursor, oldest first.
+	// Unlike changes, every created, updated and deleted event is kept
+	// until the webhook cursor passes it. Each carries its page as it is
+	// now, or as it was deleted.
+	PageEvents(cursor int64, limit int) ([]*pages.PageChange, error)
+
+	// Links
+	PageOutlinks(id string) ([]*pages.PageLink, error)
+	PageBacklinks(id string) ([]*pages.PageLink, error)
+
+	// Views
+	PageViewRecord(id, viewer string, ts, window int64) (bool, error)
+	PageViews(id string, since int64) ([]*pages.PageViewBucket, error)
+	PageViewsForAccount(account string, since int64) ([]*pages.PageViewBucket, error)
+	PageViewsTop(account string, since int64, limit int) ([]*pages.PageViewCount, error)
+
+	// Attachments
+	Attachment(id string) (*pages.Attachment, error)
+	AttachmentCreate(page, account, name, contentType, hash string, size int64) (*pages.Attachment, error)
+
+	// Comments
+	Comment(id string) (*pages.Comment, error)
+	Comments(page string) ([]*pages.Comment, error)
+	CommentCreate(page, account, parent, text string, anchor *pages.CommentAnchor) (*pages.Comment, error)
+	CommentUpdate(id, account, text string) (*pages.Comment, error)
+	CommentDelete(id, account string) (*pages.Comment, error)
+
+	// Templates
+	Template(id string) (*pages.Template, error)
+	TemplatesForAccount(account string) ([]*pages.Template, error)
+	TemplateCreate(account, name, text string) (*pages.Template, error)
+	TemplateDelete(id, account string) error
+
+	// Moderation
+	ModerationDecision(id string) (*pages.ModerationDecision, error)
+	ModerationDecisions(page string, pending bool) ([]*pages.ModerationDecision, error)
+	ModerationRecord(decision *pages.ModerationDecision) (*pages.ModerationDecision, error)
+	ModerationReview(id, reviewer string, verdict pages.ReviewVerdict) (*pages.ModerationDecision, error)
+
+	// Webhooks
+	Webhook(id string) (*pages.Webhook, error)
+	WebhooksForAccount(account string) ([]*pages.Webhook, error)
+	WebhookCreate(account, url, secret string, events []pages.PageEventType) (*pages.Webhook, error)
+	WebhookDelete(id, account string) error
+
+	// Deliveries are queued for a webhook and stay queued until they're
+	// delivered or fail for good. Deleting a webhook deletes its deliveries.
+	WebhookDeliveries(webhook string, limit int) ([]*pages.WebhookDelivery, error)
+	WebhookDeliveriesDue(ts int64, limit int) ([]*pages.WebhookDelivery, error)
+	WebhookDeliveryCreate(webhook string, event pages.PageEventType, payload string) (*pages.WebhookDelivery, error)
+	WebhookDeliveryUpdate(delivery *pages.WebhookDelivery) error
+
+	// The webhook cursor is the last page event queued for delivery.
+	// Setting it discards the events up to it.
+	WebhookCursor() (int64, error)
+	WebhookCursorSet(seq int64) error
+
+	// Notebooks form a tree for each account. Notebooks and the pages in
+	// them are ordered by position, and positions that are negative or past
+	// the last item place an item last. Only the account that created a
+	// notebook may see or change it, and only owners may file pages.
+	Notebook(id string) (*pages.Notebook, error)
+	Notebooks(account, parent string, recursive bool) ([]*pages.Notebook, error)
+	NotebookCreate(account, name, parent string) (*pages.Notebook, error)
+	NotebookRename(id, account, name string) (*pages.Notebook, error)
+	NotebookMove(id, account, parent string, position int64) (*pages.Notebook, error)
+	NotebookDelete(id, account string) error
+	NotebookPages(id string, recursive bool) ([]*pages.Page, error)
+	PageMove(id, account, notebook string, position int64) error
 
 	Description() string
 }
 
+// BlobStore stores attachment content addressed by its SHA-256 hash.
+type BlobStore interface {
+	// Put stores the content read from r and returns its hex encoded SHA-256
+	// hash and size. Nothing is stored if reading from r fails.
+	Put(r io.Reader) (hash string, size int64, err error)
+	Get(hash string) (io.ReadCloser, error)
+}
+
+// Page fields PageUpdate can change, named as they are in update masks.
+const (
+	FieldText       = "text"
+	FieldVisibility = "visibility"
+)
+
+// UpdateFields lists every field PageUpdate can change.
+var UpdateFields = []string{FieldText, FieldVisibility}
+
+// RequestFields returns the fields an update request changes: those named by
+// its mask, or every field in UpdateFields when the mask is missing or empty.
+// Without a mask visibility is only changed when it's set, since a request
+// that leaves it out can't be told apart from one making the page private.
+func RequestFields(in *pages.PageUpdateRequest) []string {
+	if in.UpdateMask != nil && len(in.UpdateMask.Paths) > 0 {
+		return in.UpdateMask.Paths
+	}
+	if in.Visibility == pages.Visibility_PRIVATE {
+		return []string{FieldText}
+	}
+	return UpdateFields
+}
+
+// HasField reports whether fields includes field.
+func HasField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// AbortBatch marks every successful item of a batch with ErrBatchAborted if
+// any item failed, and reports whether the batch must be rolled back.
+func AbortBatch(errs []error) bool {
+	failed := false
+	for _, err := range errs {
+		if err != nil {
+			failed = true
+			break
+		}
+	}
+	if !failed {
+		return false
+	}
+	for i, err := range errs {
+		if err == nil {
+			errs[i] = ErrBatchAborted
+		}
+	}
+	return true
+}
+
+// PublishTime returns the publish at time to record for a page moving to
+// status at ts. Published pages record when they were published, drafts
+// have no publish time and scheduled pages keep the time they were given.
+func PublishTime(status pages.PageStatus, publishAt, ts int64) int64 {
+	switch status {
+	case pages.PageStatus_PUBLISHED:
+		return ts
+	case pages.PageStatus_DRAFT:
+		return 0
+	}
+	return publishAt
+}
+
+// PublishedAsOf reports whether a page was published at ts. Status changes
+// aren't recorded, so published pages count as published from their publish
+// time.
+func PublishedAsOf(page *pages.Page, ts int64) bool {
+	return page.Status == pages.PageStatus_PUBLISHED && page.PublishAt <= ts
+}
+
+// ViewDay returns the start of the UTC day containing ts. Views are counted
+// in daily buckets.
+func ViewDay(ts int64) int64 {
+	return ts - ts%int64(24*time.Hour)
+}
+
+// Place returns the index an item moved to position goes at among n other
+// items. Positions that are negative or past the end place it last.
+func Place(position int64, n int) int {
+	if position < 0 || position > int64(n) {
+		return n
+	}
+	return int(position)
+}
+
 // Backend represents a state backend that can be instantiated.
 type Backend func() State
 
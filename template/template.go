@@ -0,0 +1,57 @@
+// Package template expands the {{variable}} placeholders in page templates.
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// builtin names the variables every expansion provides.
+var builtin = map[string]bool{"date": true, "time": true, "author": true}
+
+// Builtins returns the built in variables for a page created by author at t:
+// the date as YYYY-MM-DD, the time as HH:MM and the author's name.
+func Builtins(author string, t time.Time) map[string]string {
+	return map[string]string{
+		"date":   t.Format("2006-01-02"),
+		"time":   t.Format("15:04"),
+		"author": author,
+	}
+}
+
+// Fields returns the distinct custom variables used in text, in order of
+// first appearance. Built in variables aren't included.
+func Fields(text string) []string {
+	out := []string{}
+	seen := make(map[string]bool)
+	for _, m := range placeholder.FindAllStringSubmatch(text, -1) {
+		name := m[1]
+		if builtin[name] || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	return out
+}
+
+// Expand replaces every placeholder in text with its value from vars. It
+// fails if a placeholder has no value.
+func Expand(text string, vars map[string]string) (string, error) {
+	var missing string
+	out := placeholder.ReplaceAllStringFunc(text, func(m string) string {
+		name := placeholder.FindStringSubmatch(m)[1]
+		value, ok := vars[name]
+		if !ok && missing == "" {
+			missing = name
+		}
+		return value
+	})
+	if missing != "" {
+		return "", fmt.Errorf("Missing template variable '%s'", missing)
+	}
+	return out, nil
+}
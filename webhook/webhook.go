@@ -0,0 +1,163 @@
+// Package webhook signs page events and delivers them to subscribed URLs.
+// Receivers check a delivery came from the server by recomputing its
+// signature with the webhook's secret.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/nathanborror/pages/pages"
+)
+
+// Headers sent with every delivery.
+const (
+	SignatureHeader = "X-Pages-Signature"
+	EventHeader     = "X-Pages-Event"
+	DeliveryHeader  = "X-Pages-Delivery"
+)
+
+// Event returns the name of an event type as sent in the event header, such
+// as "page.created".
+func Event(t pages.PageEventType) string {
+	return "page." + strings.ToLower(t.String())
+}
+
+// Payload returns the JSON body delivered for an event. Fields are named as
+// they are in the gateway's JSON, and fields with default values are included
+// so receivers always see the event type.
+func Payload(e *pages.PageEvent) (string, error) {
+	m := jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	return m.MarshalToString(e)
+}
+
+// Sign returns the signature of a delivery body: the hex encoded HMAC-SHA256
+// of the body keyed by secret, prefixed with "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the signature of body.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// ErrPrivateAddress is returned when a delivery would connect to a loopback,
+// link-local or private address.
+var ErrPrivateAddress = errors.New("Webhook address is not public")
+
+// ValidURL reports whether a webhook may be delivered to rawurl. Only
+// absolute http and https URLs are allowed, and unless allowPrivate is set
+// their host can't be localhost or a non-public IP address. Hostnames are
+// checked again when a delivery connects, since they can resolve anywhere.
+func ValidURL(rawurl string, allowPrivate bool) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return false
+	}
+	if allowPrivate {
+		return true
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && !public(ip) {
+		return false
+	}
+	return true
+}
+
+// Client returns an HTTP client for deliveries. Unless allowPrivate is set it
+// refuses to connect to non-public addresses, checking the address each
+// connection is made to so a hostname can't be rebound to one after it's
+// validated. Redirects aren't followed and proxies from the environment
+// aren't used, since either would send the delivery somewhere unchecked.
+func Client(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !public(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which isn't routable on
+// the internet.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// public reports whether ip is a globally routable unicast address.
+func public(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// Backoff returns how long to wait before retrying a delivery that has
+// failed attempts times. The wait doubles from base with each attempt, up to
+// max.
+func Backoff(attempts int64, base, max time.Duration) time.Duration {
+	wait := base
+	for i := int64(1); i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
+
+// Deliver posts a delivery's payload to url, signed with secret, and returns
+// the response's status code. Responses other than 2xx are errors.
+func Deliver(client *http.Client, url, secret string, d *pages.WebhookDelivery) (int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Pages-Webhook")
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+	req.Header.Set(EventHeader, Event(d.Event))
+	req.Header.Set(DeliveryHeader, d.Id)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("Receiver responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,62 @@
+// Package wiki extracts titles, [[wiki links]] and #tags from page text.
+package wiki
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	link = regexp.MustCompile(`\[\[([^\[\]\n]+)\]\]`)
+	tag  = regexp.MustCompile(`(?:^|\s)#([A-Za-z][A-Za-z0-9_-]*)`)
+)
+
+// Title returns the first non-blank line of text with any Markdown heading
+// marker removed.
+func Title(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+		if line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// Links returns the distinct targets of the [[target]] and [[target|label]]
+// links in text, in order of first appearance. A target is either a page ID
+// or a page title.
+func Links(text string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, m := range link.FindAllStringSubmatch(text, -1) {
+		ref := m[1]
+		if i := strings.Index(ref, "|"); i >= 0 {
+			ref = ref[:i]
+		}
+		ref = strings.TrimSpace(ref)
+		if ref == "" || seen[strings.ToLower(ref)] {
+			continue
+		}
+		seen[strings.ToLower(ref)] = true
+		out = append(out, ref)
+	}
+	return out
+}
+
+// Tags returns the distinct #tags in text, lowercased, in order of first
+// appearance. A tag starts with a letter and follows whitespace or the start
+// of the text, so Markdown headings aren't tags.
+func Tags(text string) []string {
+	out := []string{}
+	seen := make(map[string]bool)
+	for _, m := range tag.FindAllStringSubmatch(text, -1) {
+		name := strings.ToLower(m[1])
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	return out
+}